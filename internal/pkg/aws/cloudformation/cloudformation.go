@@ -31,6 +31,9 @@ var waiters = []request.WaiterOption{
 	request.WithWaiterMaxAttempts(1080),                                   // Wait for at most 90 mins for any cfn action.
 }
 
+// driftDetectionPollInterval is how long to wait in between polls of the status of a drift detection.
+var driftDetectionPollInterval = 5 * time.Second
+
 // CloudFormation represents a client to make requests to AWS CloudFormation.
 type CloudFormation struct {
 	client
@@ -218,9 +221,26 @@ func MetadataWithStackSetName(name string) MetadataOpts {
 }
 
 // Metadata returns the Metadata property of the CloudFormation stack(set)'s template.
+// If the stack does not exist, returns ErrStackNotFound. If the stack set does not exist, returns ErrStackSetNotFound.
 func (c *CloudFormation) Metadata(opt MetadataOpts) (string, error) {
 	out, err := c.GetTemplateSummary(opt)
+	return templateSummaryMetadata(opt, out, err)
+}
+
+// MetadataWithContext is like Metadata but the request is canceled if ctx is done before it completes.
+func (c *CloudFormation) MetadataWithContext(ctx context.Context, opt MetadataOpts) (string, error) {
+	out, err := c.GetTemplateSummaryWithContext(ctx, opt)
+	return templateSummaryMetadata(opt, out, err)
+}
+
+func templateSummaryMetadata(opt MetadataOpts, out *cloudformation.GetTemplateSummaryOutput, err error) (string, error) {
 	if err != nil {
+		if opt.StackName != nil && stackDoesNotExist(err) {
+			return "", &ErrStackNotFound{name: aws.StringValue(opt.StackName)}
+		}
+		if opt.StackSetName != nil && stackSetDoesNotExist(err) {
+			return "", &ErrStackSetNotFound{name: aws.StringValue(opt.StackSetName)}
+		}
 		return "", fmt.Errorf("get template summary: %w", err)
 	}
 	return aws.StringValue(out.Metadata), nil
@@ -257,6 +277,41 @@ func (c *CloudFormation) TemplateBodyFromChangeSet(changeSetID, stackName string
 	return aws.StringValue(out.TemplateBody), nil
 }
 
+// DetectDrift starts a drift detection of a stack and waits until it completes, then returns the drift status of the stack,
+// one of the cloudformation.StackDriftStatus values such as "IN_SYNC" or "DRIFTED".
+// Drift detection is asynchronous and can take minutes for stacks with many resources: set a deadline on ctx to bound it.
+// If the stack does not exist, returns ErrStackNotFound.
+func (c *CloudFormation) DetectDrift(ctx context.Context, stackName string) (string, error) {
+	out, err := c.client.DetectStackDriftWithContext(ctx, &cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return "", &ErrStackNotFound{name: stackName}
+		}
+		return "", fmt.Errorf("detect drift of stack %s: %w", stackName, err)
+	}
+	for {
+		status, err := c.client.DescribeStackDriftDetectionStatusWithContext(ctx, &cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: out.StackDriftDetectionId,
+		})
+		if err != nil {
+			return "", fmt.Errorf("describe drift detection status of stack %s: %w", stackName, err)
+		}
+		switch aws.StringValue(status.DetectionStatus) {
+		case cloudformation.StackDriftDetectionStatusDetectionComplete:
+			return aws.StringValue(status.StackDriftStatus), nil
+		case cloudformation.StackDriftDetectionStatusDetectionFailed:
+			return "", fmt.Errorf("detect drift of stack %s: %s", stackName, aws.StringValue(status.DetectionStatusReason))
+		}
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("wait for drift detection of stack %s: %w", stackName, ctx.Err())
+		case <-time.After(driftDetectionPollInterval):
+		}
+	}
+}
+
 // Outputs returns the outputs of a stack description.
 func (c *CloudFormation) Outputs(stack *Stack) (map[string]string, error) {
 	stackDescription, err := c.Describe(stack.Name)
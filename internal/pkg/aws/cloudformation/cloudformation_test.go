@@ -468,6 +468,25 @@ func TestStackDescriber_Metadata(t *testing.T) {
 
 			wantedErr: errors.New("get template summary: some error"),
 		},
+		"should return ErrStackNotFound if the stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().GetTemplateSummary(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+
+			wantedErr: &ErrStackNotFound{name: "phonetool"},
+		},
+		"should return ErrStackSetNotFound if the stack set does not exist": {
+			isStackSet: true,
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().GetTemplateSummary(gomock.Any()).Return(nil, awserr.New(cloudformation.ErrCodeStackSetNotFoundException, "StackSet phonetool not found", nil))
+				return m
+			},
+
+			wantedErr: &ErrStackSetNotFound{name: "phonetool"},
+		},
 		"should return Metadata property of template summary on success for stack": {
 			createMock: func(ctrl *gomock.Controller) client {
 				m := mocks.NewMockclient(ctrl)
@@ -524,6 +543,34 @@ func TestStackDescriber_Metadata(t *testing.T) {
 	}
 }
 
+func TestStackDescriber_MetadataWithContext(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	m := mocks.NewMockclient(ctrl)
+	m.EXPECT().GetTemplateSummaryWithContext(ctx, &cloudformation.GetTemplateSummaryInput{
+		StackName: aws.String("phonetool"),
+	}).Return(nil, errDoesNotExist)
+	m.EXPECT().GetTemplateSummaryWithContext(ctx, &cloudformation.GetTemplateSummaryInput{
+		StackSetName: aws.String("phonetool"),
+	}).Return(&cloudformation.GetTemplateSummaryOutput{
+		Metadata: aws.String("hello"),
+	}, nil)
+	c := CloudFormation{
+		client: m,
+	}
+
+	// WHEN
+	_, stackErr := c.MetadataWithContext(ctx, MetadataWithStackName("phonetool"))
+	metadata, stackSetErr := c.MetadataWithContext(ctx, MetadataWithStackSetName("phonetool"))
+
+	// THEN
+	require.EqualError(t, stackErr, (&ErrStackNotFound{name: "phonetool"}).Error())
+	require.NoError(t, stackSetErr)
+	require.Equal(t, "hello", metadata)
+}
+
 func TestCloudFormation_Describe(t *testing.T) {
 	testCases := map[string]struct {
 		createMock  func(ctrl *gomock.Controller) client
@@ -693,6 +740,87 @@ func TestCloudFormation_TemplateBody(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DetectDrift(t *testing.T) {
+	oldInterval := driftDetectionPollInterval
+	driftDetectionPollInterval = 0
+	defer func() {
+		driftDetectionPollInterval = oldInterval
+	}()
+	testCases := map[string]struct {
+		createMock   func(ctrl *gomock.Controller) client
+		wantedStatus string
+		wantedErr    string
+	}{
+		"return ErrStackNotFound if stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDriftWithContext(gomock.Any(), gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedErr: (&ErrStackNotFound{name: mockStack.Name}).Error(),
+		},
+		"wraps error if the detection fails": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDriftWithContext(gomock.Any(), gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatusWithContext(gomock.Any(), gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:       aws.String(cloudformation.StackDriftDetectionStatusDetectionFailed),
+					DetectionStatusReason: aws.String("some reason"),
+				}, nil)
+				return m
+			},
+			wantedErr: "detect drift of stack id: some reason",
+		},
+		"waits for the detection to complete": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDriftWithContext(gomock.Any(), &cloudformation.DetectStackDriftInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackDriftDetectionStatusWithContext(gomock.Any(), &cloudformation.DescribeStackDriftDetectionStatusInput{
+						StackDriftDetectionId: aws.String("1234"),
+					}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionInProgress),
+					}, nil),
+					m.EXPECT().DescribeStackDriftDetectionStatusWithContext(gomock.Any(), gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus:  aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+						StackDriftStatus: aws.String(cloudformation.StackDriftStatusDrifted),
+					}, nil),
+				)
+				return m
+			},
+			wantedStatus: "DRIFTED",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			status, err := c.DetectDrift(context.Background(), mockStack.Name)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStatus, status)
+		})
+	}
+}
+
 func TestCloudFormation_TemplateBodyFromChangeSet(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
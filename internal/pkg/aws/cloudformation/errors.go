@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
 
 // ErrChangeSetEmpty occurs when the change set does not contain any new or updated resources.
@@ -38,6 +39,15 @@ func (e *ErrStackNotFound) Error() string {
 	return fmt.Sprintf("stack named %s cannot be found", e.name)
 }
 
+// ErrStackSetNotFound occurs when a CloudFormation stack set does not exist.
+type ErrStackSetNotFound struct {
+	name string
+}
+
+func (e *ErrStackSetNotFound) Error() string {
+	return fmt.Sprintf("stack set named %s cannot be found", e.name)
+}
+
 // ErrChangeSetNotExecutable occurs when the change set cannot be executed.
 type ErrChangeSetNotExecutable struct {
 	cs    *changeSet
@@ -70,3 +80,11 @@ func stackDoesNotExist(err error) bool {
 	}
 	return false
 }
+
+// stackSetDoesNotExist returns true if the underlying error is a stack set doesn't exist.
+func stackSetDoesNotExist(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == cloudformation.ErrCodeStackSetNotFoundException
+	}
+	return false
+}
@@ -6,6 +6,7 @@ package stackset
 // Description represents a created stack set resource.
 type Description struct {
 	ID       string
+	ARN      string
 	Name     string
 	Template string
 }
@@ -75,6 +75,7 @@ func (ss *StackSet) Describe(name string) (Description, error) {
 	}
 	return Description{
 		ID:       aws.StringValue(resp.StackSet.StackSetId),
+		ARN:      aws.StringValue(resp.StackSet.StackSetARN),
 		Name:     aws.StringValue(resp.StackSet.StackSetName),
 		Template: aws.StringValue(resp.StackSet.TemplateBody),
 	}, nil
@@ -105,6 +105,7 @@ func TestStackSet_Describe(t *testing.T) {
 				}).Return(&cloudformation.DescribeStackSetOutput{
 					StackSet: &cloudformation.StackSet{
 						StackSetId:   aws.String(testName),
+						StackSetARN:  aws.String("arn:aws:cloudformation:us-west-2:123456789012:stackset/" + testName),
 						StackSetName: aws.String(testName),
 						TemplateBody: aws.String("body"),
 					},
@@ -113,6 +114,7 @@ func TestStackSet_Describe(t *testing.T) {
 			},
 			wantedDescr: Description{
 				ID:       testName,
+				ARN:      "arn:aws:cloudformation:us-west-2:123456789012:stackset/" + testName,
 				Name:     testName,
 				Template: "body",
 			},
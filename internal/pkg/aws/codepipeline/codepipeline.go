@@ -42,20 +42,32 @@ type CodePipeline struct {
 
 // Pipeline represents an existing CodePipeline resource.
 type Pipeline struct {
-	Name      string    `json:"name"`
-	Region    string    `json:"region"`
-	AccountID string    `json:"accountId"`
-	Stages    []*Stage  `json:"stages"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	Name       string    `json:"name"`
+	Region     string    `json:"region"`
+	AccountID  string    `json:"accountId"`
+	Repository string    `json:"repository,omitempty"` // Repository tracked by the source stage.
+	Branch     string    `json:"branch,omitempty"`     // Branch tracked by the source stage.
+	Stages     []*Stage  `json:"stages"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
 }
 
 // Stage wraps the codepipeline pipeline stage.
+// The category, provider and details of the stage are the ones of its first action.
 type Stage struct {
+	Name     string    `json:"name"`
+	Category string    `json:"category"`
+	Provider string    `json:"provider"`
+	Details  string    `json:"details"`
+	Actions  []*Action `json:"-"` // Actions of the stage, in order.
+}
+
+// Action wraps an action of a codepipeline pipeline stage.
+type Action struct {
 	Name     string `json:"name"`
 	Category string `json:"category"`
 	Provider string `json:"provider"`
-	Details  string `json:"details"`
+	Details  string `json:"details,omitempty"`
 }
 
 // PipelineState represents a Pipeline's status.
@@ -133,21 +145,27 @@ func (c *CodePipeline) GetPipeline(name string) (*Pipeline, error) {
 	}
 
 	var stages []*Stage
+	var repository, branch string
 	for _, s := range pipeline.Stages {
 		stage, err := c.getStage(s)
 		if err != nil {
 			return nil, fmt.Errorf("get stage for pipeline: %s", pipelineArn)
 		}
+		if stage.Category == "Source" && repository == "" {
+			repository, branch = sourceRepository(stage.Provider, s.Actions[0].Configuration)
+		}
 		stages = append(stages, stage)
 	}
 
 	return &Pipeline{
-		Name:      aws.StringValue(pipeline.Name),
-		Region:    parsedArn.Region,
-		AccountID: parsedArn.AccountID,
-		Stages:    stages,
-		CreatedAt: *metadata.Created,
-		UpdatedAt: *metadata.Updated,
+		Name:       aws.StringValue(pipeline.Name),
+		Region:     parsedArn.Region,
+		AccountID:  parsedArn.AccountID,
+		Repository: repository,
+		Branch:     branch,
+		Stages:     stages,
+		CreatedAt:  *metadata.Created,
+		UpdatedAt:  *metadata.Updated,
 	}, nil
 }
 
@@ -198,7 +216,8 @@ func (c *CodePipeline) RetryStageExecution(pipelineName, stageName string) error
 	return nil
 }
 
-// GetPipelineByTags retrieves all of pipelines for an application.
+// GetPipelinesByTags retrieves all of pipelines for an application.
+// The resourcegroups client pages through the tagged resources, so every pipeline is returned regardless of their number.
 func (c *CodePipeline) GetPipelinesByTags(tags map[string]string) ([]*Pipeline, error) {
 	var pipelines []*Pipeline
 	resources, err := c.rgClient.GetResourcesByTags(pipelineResourceType, tags)
@@ -263,6 +282,20 @@ func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 	}, nil
 }
 
+// LatestExecutionStatus returns the status of the most recent execution of a pipeline,
+// such as "InProgress", "Succeeded" or "Failed".
+// It returns "" if the pipeline was never executed.
+func (c *CodePipeline) LatestExecutionStatus(pipelineName string) (string, error) {
+	summary, err := c.latestExecution(pipelineName)
+	if err != nil {
+		return "", err
+	}
+	if summary == nil {
+		return "", nil
+	}
+	return aws.StringValue(summary.Status), nil
+}
+
 // HumanString returns the stringified PipelineState struct with human readable format.
 // Example output:
 //   DeployTo-test	Deploy	Cloudformation	stackname: dinder-test-test
@@ -278,61 +311,89 @@ func (ss *StageState) HumanString() string {
 }
 
 func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
-	name := aws.StringValue(s.Name)
-	var category, provider, details string
-
-	if len(s.Actions) > 0 {
+	stage := &Stage{
+		Name: aws.StringValue(s.Name),
+	}
+	for _, a := range s.Actions {
+		stage.Actions = append(stage.Actions, getAction(a))
+	}
+	if len(stage.Actions) > 0 {
 		// Currently, we only support Source, Build and Deploy stages, all of which must contain at least one action.
-		action := s.Actions[0]
-		category = aws.StringValue(action.ActionTypeId.Category)
-		provider = aws.StringValue(action.ActionTypeId.Provider)
-
-		config := action.Configuration
-
-		switch category {
-
-		case "Source":
-			// https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#structure-configuration-examples
-			switch provider {
-			case "GitHub":
-				details = fmt.Sprintf("Repository: %s/%s", aws.StringValue(config["Owner"]), aws.StringValue(config["Repo"]))
-			case "CodeCommit":
-				details = fmt.Sprintf("Repository: %s", aws.StringValue(config["RepositoryName"]))
-			case "CodeStarSourceConnection":
-				details = fmt.Sprintf("Repository: %s", aws.StringValue(config["FullRepositoryId"]))
-			}
-		case "Build":
-			// Currently, we use CodeBuild only for the build stage: https://docs.aws.amazon.com/codepipeline/latest/userguide/action-reference-CodeBuild.html#action-reference-CodeBuild-config
-			details = fmt.Sprintf("BuildProject: %s", aws.StringValue(config["ProjectName"]))
-		case "Deploy":
-			// Currently, we use Cloudformation only for the build stage: https://docs.aws.amazon.com/codepipeline/latest/userguide/action-reference-CloudFormation.html#action-reference-CloudFormation-config
-			details = fmt.Sprintf("StackName: %s", aws.StringValue(config["StackName"]))
-		}
+		action := stage.Actions[0]
+		stage.Category = action.Category
+		stage.Provider = action.Provider
+		stage.Details = action.Details
 	}
+	return stage, nil
+}
 
-	stage := &Stage{
-		Name:     name,
+func getAction(a *cp.ActionDeclaration) *Action {
+	category := aws.StringValue(a.ActionTypeId.Category)
+	provider := aws.StringValue(a.ActionTypeId.Provider)
+	config := a.Configuration
+
+	var details string
+	switch category {
+	case "Source":
+		if repository, _ := sourceRepository(provider, config); repository != "" {
+			details = fmt.Sprintf("Repository: %s", repository)
+		}
+	case "Build":
+		// Currently, we use CodeBuild only for the build stage: https://docs.aws.amazon.com/codepipeline/latest/userguide/action-reference-CodeBuild.html#action-reference-CodeBuild-config
+		details = fmt.Sprintf("BuildProject: %s", aws.StringValue(config["ProjectName"]))
+	case "Deploy":
+		// Currently, we use Cloudformation only for the build stage: https://docs.aws.amazon.com/codepipeline/latest/userguide/action-reference-CloudFormation.html#action-reference-CloudFormation-config
+		details = fmt.Sprintf("StackName: %s", aws.StringValue(config["StackName"]))
+	}
+	return &Action{
+		Name:     aws.StringValue(a.Name),
 		Category: category,
 		Provider: provider,
 		Details:  details,
 	}
-	return stage, nil
+}
+
+// sourceRepository returns the repository and the branch tracked by a source action.
+// If the provider is not supported, it returns empty strings.
+func sourceRepository(provider string, config map[string]*string) (repository, branch string) {
+	// https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#structure-configuration-examples
+	switch provider {
+	case "GitHub":
+		return fmt.Sprintf("%s/%s", aws.StringValue(config["Owner"]), aws.StringValue(config["Repo"])), aws.StringValue(config["Branch"])
+	case "CodeCommit":
+		return aws.StringValue(config["RepositoryName"]), aws.StringValue(config["BranchName"])
+	case "CodeStarSourceConnection":
+		return aws.StringValue(config["FullRepositoryId"]), aws.StringValue(config["BranchName"])
+	}
+	return "", ""
 }
 
 // pipelineExecutionID returns the ExecutionID of the most recent execution of a pipeline.
 func (c *CodePipeline) pipelineExecutionID(pipelineName string) (string, error) {
+	summary, err := c.latestExecution(pipelineName)
+	if err != nil {
+		return "", err
+	}
+	if summary == nil {
+		return "", fmt.Errorf("no pipeline execution IDs found for %s", pipelineName)
+	}
+	return aws.StringValue(summary.PipelineExecutionId), nil
+}
+
+// latestExecution returns the summary of the most recent execution of a pipeline, or nil if there is none.
+func (c *CodePipeline) latestExecution(pipelineName string) (*cp.PipelineExecutionSummary, error) {
 	input := &cp.ListPipelineExecutionsInput{
 		MaxResults:   aws.Int64(1),
 		PipelineName: &pipelineName,
 	}
 	output, err := c.client.ListPipelineExecutions(input)
 	if err != nil {
-		return "", fmt.Errorf("list pipeline execution for %s: %w", pipelineName, err)
+		return nil, fmt.Errorf("list pipeline execution for %s: %w", pipelineName, err)
 	}
 	if len(output.PipelineExecutionSummaries) == 0 {
-		return "", fmt.Errorf("no pipeline execution IDs found for %s", pipelineName)
+		return nil, nil
 	}
-	return aws.StringValue(output.PipelineExecutionSummaries[0].PipelineExecutionId), nil
+	return output.PipelineExecutionSummaries[0], nil
 }
 
 func (c *CodePipeline) getPipelineName(resourceArn string) (string, error) {
@@ -145,27 +145,38 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 
 			},
 			expectedOut: &Pipeline{
-				Name:      mockPipelineName,
-				Region:    "us-west-2",
-				AccountID: "1234567890",
+				Name:       mockPipelineName,
+				Region:     "us-west-2",
+				AccountID:  "1234567890",
+				Repository: "badgoose/repo",
+				Branch:     "main",
 				Stages: []*Stage{
 					{
 						Name:     "Source",
 						Category: "Source",
 						Provider: "GitHub",
 						Details:  "Repository: badgoose/repo",
+						Actions: []*Action{
+							{Name: "SourceCodeFor-dinder", Category: "Source", Provider: "GitHub", Details: "Repository: badgoose/repo"},
+						},
 					},
 					{
 						Name:     "Build",
 						Category: "Build",
 						Provider: "CodeBuild",
 						Details:  "BuildProject: pipeline-dinder-badgoose-repo-BuildProject",
+						Actions: []*Action{
+							{Name: "Build", Category: "Build", Provider: "CodeBuild", Details: "BuildProject: pipeline-dinder-badgoose-repo-BuildProject"},
+						},
 					},
 					{
 						Name:     "DeployTo-test",
 						Category: "Deploy",
 						Provider: "CloudFormation",
 						Details:  "StackName: dinder-test-test",
+						Actions: []*Action{
+							{Name: "CreateOrUpdate-test-test", Category: "Deploy", Provider: "CloudFormation", Details: "StackName: dinder-test-test"},
+						},
 					},
 				},
 				CreatedAt: mockTime,
@@ -193,15 +204,20 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 
 			},
 			expectedOut: &Pipeline{
-				Name:      mockPipelineName,
-				Region:    "us-west-2",
-				AccountID: "1234567890",
+				Name:       mockPipelineName,
+				Region:     "us-west-2",
+				AccountID:  "1234567890",
+				Repository: "badgoose/repo",
+				Branch:     "main",
 				Stages: []*Stage{
 					{
 						Name:     "Source",
 						Category: "Source",
 						Provider: "GitHub",
 						Details:  "Repository: badgoose/repo",
+						Actions: []*Action{
+							{Name: "SourceCodeFor-dinder", Category: "Source", Provider: "GitHub", Details: "Repository: badgoose/repo"},
+						},
 					},
 					{
 						Name:     "DummyStage",
@@ -657,3 +673,170 @@ func TestCodePipeline_RetryStageExecution(t *testing.T) {
 		})
 	}
 }
+
+func TestCodePipeline_LatestExecutionStatus(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockInput := &codepipeline.ListPipelineExecutionsInput{
+		MaxResults:   aws.Int64(1),
+		PipelineName: aws.String(mockPipelineName),
+	}
+	mockErr := errors.New("some error")
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedStatus string
+		expectedError  error
+	}{
+		"returns the status of the most recent execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(mockInput).Return(&codepipeline.ListPipelineExecutionsOutput{
+					PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+						{
+							PipelineExecutionId: aws.String("12345678-fake-exec-utio-nid987654321"),
+							Status:              aws.String("Failed"),
+						},
+					},
+				}, nil)
+			},
+			expectedStatus: "Failed",
+		},
+		"returns an empty status if the pipeline was never executed": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(mockInput).Return(&codepipeline.ListPipelineExecutionsOutput{
+					PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{},
+				}, nil)
+			},
+			expectedStatus: "",
+		},
+		"returns wrapped error if ListPipelineExecutions fails": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(mockInput).Return(nil, mockErr)
+			},
+			expectedError: fmt.Errorf("list pipeline execution for pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			mockrgClient := mocks.NewMockresourceGetter(ctrl)
+			mocks := codepipelineMocks{
+				cp: mockClient,
+				rg: mockrgClient,
+			}
+			tc.callMocks(mocks)
+
+			cp := CodePipeline{
+				client:   mockClient,
+				rgClient: mockrgClient,
+			}
+
+			// WHEN
+			status, err := cp.LatestExecutionStatus(mockPipelineName)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedStatus, status)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_GetPipelinesByTags(t *testing.T) {
+	testTags := map[string]string{
+		"copilot-application": "dinder",
+	}
+	mockTime := time.Now()
+	mockPipelineOutput := func(name string) *codepipeline.GetPipelineOutput {
+		return &codepipeline.GetPipelineOutput{
+			Pipeline: &codepipeline.PipelineDeclaration{
+				Name: aws.String(name),
+			},
+			Metadata: &codepipeline.PipelineMetadata{
+				PipelineArn: aws.String("arn:aws:codepipeline:us-west-2:1234567890:" + name),
+				Created:     &mockTime,
+				Updated:     &mockTime,
+			},
+		}
+	}
+	mockError := errors.New("some error")
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedNames []string
+		expectedError error
+	}{
+		"returns every pipeline listed by the resourcegroups client": {
+			callMocks: func(m codepipelineMocks) {
+				m.rg.EXPECT().GetResourcesByTags(pipelineResourceType, testTags).Return([]*rg.Resource{
+					{ARN: "arn:aws:codepipeline:us-west-2:1234567890:pipeline-dinder-1"},
+					{ARN: "arn:aws:codepipeline:us-west-2:1234567890:pipeline-dinder-2"},
+					{ARN: "arn:aws:codepipeline:us-west-2:1234567890:pipeline-dinder-3"},
+				}, nil)
+				for _, name := range []string{"pipeline-dinder-1", "pipeline-dinder-2", "pipeline-dinder-3"} {
+					m.cp.EXPECT().GetPipeline(&codepipeline.GetPipelineInput{Name: aws.String(name)}).Return(mockPipelineOutput(name), nil)
+				}
+			},
+			expectedNames: []string{"pipeline-dinder-1", "pipeline-dinder-2", "pipeline-dinder-3"},
+		},
+		"should return error from resourcegroups client": {
+			callMocks: func(m codepipelineMocks) {
+				m.rg.EXPECT().GetResourcesByTags(pipelineResourceType, testTags).Return(nil, mockError)
+			},
+			expectedError: mockError,
+		},
+		"should return error if fail to get a pipeline": {
+			callMocks: func(m codepipelineMocks) {
+				m.rg.EXPECT().GetResourcesByTags(pipelineResourceType, testTags).Return([]*rg.Resource{
+					{ARN: "arn:aws:codepipeline:us-west-2:1234567890:pipeline-dinder-1"},
+				}, nil)
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("get pipeline pipeline-dinder-1: %w", mockError),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			mockrgClient := mocks.NewMockresourceGetter(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+				rg: mockrgClient,
+			})
+
+			cp := CodePipeline{
+				client:   mockClient,
+				rgClient: mockrgClient,
+			}
+
+			// WHEN
+			pipelines, err := cp.GetPipelinesByTags(testTags)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				var names []string
+				for _, pipeline := range pipelines {
+					names = append(names, pipeline.Name)
+				}
+				require.Equal(t, tc.expectedNames, names)
+			}
+		})
+	}
+}
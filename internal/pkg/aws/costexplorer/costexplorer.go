@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package costexplorer provides a client to make API requests to AWS Cost Explorer.
+package costexplorer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+)
+
+const (
+	unblendedCostMetric = "UnblendedCost"
+	dateLayout          = "2006-01-02"
+)
+
+type api interface {
+	GetCostAndUsage(input *costexplorer.GetCostAndUsageInput) (*costexplorer.GetCostAndUsageOutput, error)
+}
+
+// CostExplorer wraps an AWS Cost Explorer client.
+type CostExplorer struct {
+	client api
+}
+
+// Cost is an amount of money spent in a currency.
+type Cost struct {
+	Amount float64
+	Unit   string // Currency of the amount, such as "USD".
+}
+
+// New returns a CostExplorer configured against the input session.
+func New(s *session.Session) *CostExplorer {
+	return &CostExplorer{
+		client: costexplorer.New(s),
+	}
+}
+
+// CostsByTag returns the unblended cost of the resources with the given tags between start and end,
+// grouped by the value of the groupBy tag key. Dates are truncated to the day, and end is exclusive.
+// Costs are only grouped by tags that are activated as cost allocation tags in the account.
+func (ce *CostExplorer) CostsByTag(tags map[string]string, groupBy string, start, end time.Time) (map[string]Cost, error) {
+	var filters []*costexplorer.Expression
+	for k, v := range tags {
+		filters = append(filters, &costexplorer.Expression{
+			Tags: &costexplorer.TagValues{
+				Key:    aws.String(k),
+				Values: aws.StringSlice([]string{v}),
+			},
+		})
+	}
+	var filter *costexplorer.Expression
+	switch len(filters) {
+	case 0:
+	case 1:
+		filter = filters[0]
+	default:
+		filter = &costexplorer.Expression{And: filters}
+	}
+
+	costs := make(map[string]Cost)
+	var token *string
+	for {
+		out, err := ce.client.GetCostAndUsage(&costexplorer.GetCostAndUsageInput{
+			Filter:      filter,
+			Granularity: aws.String(costexplorer.GranularityMonthly),
+			GroupBy: []*costexplorer.GroupDefinition{
+				{
+					Type: aws.String(costexplorer.GroupDefinitionTypeTag),
+					Key:  aws.String(groupBy),
+				},
+			},
+			Metrics: aws.StringSlice([]string{unblendedCostMetric}),
+			TimePeriod: &costexplorer.DateInterval{
+				Start: aws.String(start.Format(dateLayout)),
+				End:   aws.String(end.Format(dateLayout)),
+			},
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get cost and usage grouped by tag %s: %w", groupBy, err)
+		}
+		for _, result := range out.ResultsByTime {
+			for _, group := range result.Groups {
+				metric, ok := group.Metrics[unblendedCostMetric]
+				if !ok || len(group.Keys) == 0 {
+					continue
+				}
+				amount, err := strconv.ParseFloat(aws.StringValue(metric.Amount), 64)
+				if err != nil {
+					return nil, fmt.Errorf("parse cost %s: %w", aws.StringValue(metric.Amount), err)
+				}
+				// Keys of groups by tag are formatted as "<key>$<value>".
+				value := strings.TrimPrefix(aws.StringValue(group.Keys[0]), groupBy+"$")
+				cost := costs[value]
+				cost.Amount += amount
+				cost.Unit = aws.StringValue(metric.Unit)
+				costs[value] = cost
+			}
+		}
+		if aws.StringValue(out.NextPageToken) == "" {
+			break
+		}
+		token = out.NextPageToken
+	}
+	return costs, nil
+}
@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package costexplorer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostExplorer_CostsByTag(t *testing.T) {
+	testStart := time.Date(2021, time.March, 5, 12, 0, 0, 0, time.UTC)
+	testEnd := time.Date(2021, time.April, 4, 12, 0, 0, 0, time.UTC)
+	mockInput := func(token *string) *costexplorer.GetCostAndUsageInput {
+		return &costexplorer.GetCostAndUsageInput{
+			Filter: &costexplorer.Expression{
+				Tags: &costexplorer.TagValues{
+					Key:    aws.String("copilot-application"),
+					Values: aws.StringSlice([]string{"phonetool"}),
+				},
+			},
+			Granularity: aws.String("MONTHLY"),
+			GroupBy: []*costexplorer.GroupDefinition{
+				{Type: aws.String("TAG"), Key: aws.String("copilot-environment")},
+			},
+			Metrics: aws.StringSlice([]string{"UnblendedCost"}),
+			TimePeriod: &costexplorer.DateInterval{
+				Start: aws.String("2021-03-05"),
+				End:   aws.String("2021-04-04"),
+			},
+			NextPageToken: token,
+		}
+	}
+	group := func(key, amount string) *costexplorer.Group {
+		return &costexplorer.Group{
+			Keys: aws.StringSlice([]string{key}),
+			Metrics: map[string]*costexplorer.MetricValue{
+				"UnblendedCost": {Amount: aws.String(amount), Unit: aws.String("USD")},
+			},
+		}
+	}
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      map[string]Cost
+		wantedError error
+	}{
+		"sums the costs of each tag value across months and pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostAndUsage(mockInput(nil)).Return(&costexplorer.GetCostAndUsageOutput{
+					ResultsByTime: []*costexplorer.ResultByTime{
+						{Groups: []*costexplorer.Group{group("copilot-environment$test", "1.5"), group("copilot-environment$prod", "10")}},
+					},
+					NextPageToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetCostAndUsage(mockInput(aws.String("next"))).Return(&costexplorer.GetCostAndUsageOutput{
+					ResultsByTime: []*costexplorer.ResultByTime{
+						{Groups: []*costexplorer.Group{group("copilot-environment$test", "2.25"), group("copilot-environment$", "0.5")}},
+					},
+				}, nil)
+			},
+
+			wanted: map[string]Cost{
+				"test": {Amount: 3.75, Unit: "USD"},
+				"prod": {Amount: 10, Unit: "USD"},
+				"":     {Amount: 0.5, Unit: "USD"},
+			},
+		},
+		"wraps error from API call": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostAndUsage(gomock.Any()).Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("get cost and usage grouped by tag copilot-environment: %w", testError),
+		},
+		"returns error if the amount isn't a number": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostAndUsage(gomock.Any()).Return(&costexplorer.GetCostAndUsageOutput{
+					ResultsByTime: []*costexplorer.ResultByTime{
+						{Groups: []*costexplorer.Group{group("copilot-environment$test", "lots")}},
+					},
+				}, nil)
+			},
+
+			wantedError: errors.New(`parse cost lots: strconv.ParseFloat: parsing "lots": invalid syntax`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			ce := CostExplorer{client: m}
+
+			// WHEN
+			actual, err := ce.CostsByTag(map[string]string{"copilot-application": "phonetool"}, "copilot-environment", testStart, testEnd)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
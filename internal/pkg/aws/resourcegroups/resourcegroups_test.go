@@ -153,3 +153,56 @@ func TestResourceGroups_GetResourcesByTags(t *testing.T) {
 		})
 	}
 }
+
+// pagedAPI is a fake tagging API client that serves the resources in pages of pageSize items.
+type pagedAPI struct {
+	arns     []string
+	pageSize int
+
+	calls int
+}
+
+func (a *pagedAPI) GetResources(in *rgapi.GetResourcesInput) (*rgapi.GetResourcesOutput, error) {
+	a.calls++
+	start := 0
+	if token := aws.StringValue(in.PaginationToken); token != "" {
+		if _, err := fmt.Sscanf(token, "page-%d", &start); err != nil {
+			return nil, err
+		}
+	}
+	end := start + a.pageSize
+	out := &rgapi.GetResourcesOutput{}
+	if end < len(a.arns) {
+		out.PaginationToken = aws.String(fmt.Sprintf("page-%d", end))
+	} else {
+		end = len(a.arns)
+		out.PaginationToken = aws.String("")
+	}
+	for _, arn := range a.arns[start:end] {
+		out.ResourceTagMappingList = append(out.ResourceTagMappingList, &rgapi.ResourceTagMapping{
+			ResourceARN: aws.String(arn),
+		})
+	}
+	return out, nil
+}
+
+func TestResourceGroups_GetResourcesByTags_ManyPages(t *testing.T) {
+	// GIVEN
+	var arns []string
+	for i := 0; i < 250; i++ {
+		arns = append(arns, fmt.Sprintf("arn:aws:codepipeline:us-west-2:1234567890:pipeline-%d", i))
+	}
+	client := &pagedAPI{arns: arns, pageSize: 100}
+	rg := &ResourceGroups{client: client}
+
+	// WHEN
+	resources, err := rg.GetResourcesByTags("codepipeline:pipeline", testTags)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, 3, client.calls)
+	require.Len(t, resources, len(arns))
+	for i, resource := range resources {
+		require.Equal(t, arns[i], resource.ARN)
+	}
+}
@@ -73,6 +73,20 @@ func (s *S3) PutArtifact(bucket, fileName string, data io.Reader) (string, error
 	return resp.Location, nil
 }
 
+// PutObject uploads data to a S3 bucket under key with the given content type and returns its url.
+func (s *S3) PutObject(bucket, key, contentType string, data io.Reader) (string, error) {
+	resp, err := s.s3Manager.Upload(&s3manager.UploadInput{
+		Body:        data,
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", fmt.Errorf("put %s to bucket %s: %w", key, bucket, err)
+	}
+	return resp.Location, nil
+}
+
 // ZipAndUpload zips files and uploads zips all files and uploads the zipped file to an S3 bucket under the specified key.
 func (s *S3) ZipAndUpload(bucket, key string, files ...NamedBinary) (string, error) {
 	buf := new(bytes.Buffer)
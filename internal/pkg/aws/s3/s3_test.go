@@ -11,6 +11,7 @@ import (
 	"io"
 	"io/ioutil"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -92,6 +93,63 @@ func TestS3_PutArtifact(t *testing.T) {
 	}
 }
 
+func TestS3_PutObject(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3ManagerClient func(m *mocks.Mocks3ManagerAPI)
+
+		wantErr error
+		wantURL string
+	}{
+		"should upload the object with its content type and return its url": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(&s3manager.UploadInput{
+					Body:        strings.NewReader("{}"),
+					Bucket:      aws.String("mockBucket"),
+					Key:         aws.String("snapshots/phonetool.json"),
+					ContentType: aws.String("application/json"),
+				}).Return(&s3manager.UploadOutput{
+					Location: "https://mockBucket/snapshots/phonetool.json",
+				}, nil)
+			},
+
+			wantURL: "https://mockBucket/snapshots/phonetool.json",
+		},
+		"should return error if fail to upload": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: errors.New("put snapshots/phonetool.json to bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3ManagerClient := mocks.NewMocks3ManagerAPI(ctrl)
+			tc.mockS3ManagerClient(mockS3ManagerClient)
+
+			service := S3{
+				s3Manager: mockS3ManagerClient,
+			}
+
+			// WHEN
+			gotURL, gotErr := service.PutObject("mockBucket", "snapshots/phonetool.json", "application/json", strings.NewReader("{}"))
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantURL, gotURL)
+		})
+	}
+}
+
 func TestS3_ZipAndUpload(t *testing.T) {
 	testCases := map[string]struct {
 		mockS3ManagerClient func(m *mocks.Mocks3ManagerAPI)
@@ -111,6 +111,36 @@ func (p *Provider) FromRole(roleARN string, region string) (*session.Session, er
 	return sess, nil
 }
 
+// FromRoleChain returns a session configured against the last role of roleARNs and the input region.
+// The roles are assumed in sequence starting from the default session, each role with the credentials of the previous one,
+// for example to reach a member account of an organization from its management account.
+func (p *Provider) FromRoleChain(roleARNs []string, region string) (*session.Session, error) {
+	if len(roleARNs) == 0 {
+		return nil, fmt.Errorf("assume role chain: no roles to assume")
+	}
+	sess, err := p.Default()
+	if err != nil {
+		return nil, fmt.Errorf("error creating default session: %w", err)
+	}
+	for i, roleARN := range roleARNs {
+		creds := stscreds.NewCredentials(sess, roleARN)
+		// Retrieve the credentials right away so that the failing hop is known, instead of on the first request.
+		if _, err := creds.Get(); err != nil {
+			return nil, fmt.Errorf("assume role %s (hop %d of %d): %w", roleARN, i+1, len(roleARNs), err)
+		}
+		sess, err = session.NewSession(
+			newConfig().
+				WithCredentials(creds).
+				WithRegion(region),
+		)
+		if err != nil {
+			return nil, err
+		}
+		sess.Handlers.Build.PushBackNamed(userAgentHandler())
+	}
+	return sess, nil
+}
+
 // FromStaticCreds returns a session from static credentials.
 func (p *Provider) FromStaticCreds(accessKeyID, secretAccessKey, sessionToken string) (*session.Session, error) {
 	conf := newConfig()
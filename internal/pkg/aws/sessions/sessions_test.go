@@ -140,3 +140,11 @@ func TestCreds(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_FromRoleChain(t *testing.T) {
+	// WHEN
+	_, err := NewProvider().FromRoleChain(nil, "us-west-2")
+
+	// THEN
+	require.EqualError(t, err, "assume role chain: no roles to assume")
+}
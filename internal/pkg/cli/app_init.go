@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -41,6 +42,7 @@ type initAppVars struct {
 type initAppOpts struct {
 	initAppVars
 
+	region   string // Region of the default session, which becomes the home region of the application.
 	identity identityService
 	store    applicationStore
 	route53  domainHostedZoneGetter
@@ -68,6 +70,7 @@ func newInitAppOpts(vars initAppVars) (*initAppOpts, error) {
 
 	return &initAppOpts{
 		initAppVars: vars,
+		region:      aws.StringValue(sess.Config.Region),
 		identity:    identity.New(sess),
 		store:       store,
 		route53:     route53.New(sess),
@@ -194,6 +197,7 @@ func (o *initAppOpts) Execute() error {
 		Domain:             o.domainName,
 		DomainHostedZoneID: hostedZoneID,
 		Tags:               o.resourceTags,
+		Region:             o.region,
 	})
 }
 
@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
-	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -25,18 +23,23 @@ const (
 )
 
 type showAppVars struct {
-	name             string
-	shouldOutputJSON bool
+	name                  string
+	shouldOutputJSON      bool
+	shouldOutputResources bool
+	bestEffort            bool
+	shouldOutputConsole   bool
+	region                string
 }
 
 type showAppOpts struct {
 	showAppVars
 
-	prompt      prompter
-	store       store
-	w           io.Writer
-	sel         appSelector
-	pipelineSvc pipelineGetter
+	prompt           prompter
+	store            store
+	w                io.Writer
+	sel              appSelector
+	describer        appDescriber
+	initAppDescriber func() error
 }
 
 func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
@@ -44,20 +47,41 @@ func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
-
-	defaultSession, err := sessions.NewProvider().Default()
+	deployStore, err := deploy.NewStore(store)
 	if err != nil {
-		return nil, fmt.Errorf("default session: %w", err)
+		return nil, fmt.Errorf("new deploy store: %w", err)
 	}
+
 	prompter := prompt.New()
-	return &showAppOpts{
+	opts := &showAppOpts{
 		showAppVars: vars,
 		store:       store,
 		w:           log.OutputWriter,
 		prompt:      prompter,
 		sel:         selector.NewSelect(prompter, store),
-		pipelineSvc: codepipeline.New(defaultSession),
-	}, nil
+	}
+	opts.initAppDescriber = func() error {
+		describerOpts := []describe.AppDescriberOption{
+			describe.WithConfigStore(store),
+			describe.WithDeployStore(deployStore),
+		}
+		if opts.shouldOutputResources {
+			describerOpts = append(describerOpts, describe.WithStackARNs())
+		}
+		if opts.bestEffort {
+			describerOpts = append(describerOpts, describe.WithBestEffort())
+		}
+		if opts.shouldOutputConsole {
+			describerOpts = append(describerOpts, describe.WithConsoleURLs())
+		}
+		d, err := describe.NewAppDescriber(opts.name, describerOpts...)
+		if err != nil {
+			return fmt.Errorf("new app describer for application %s: %w", opts.name, err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
 }
 
 // Validate returns an error if the values provided by the user are invalid.
@@ -83,15 +107,25 @@ func (o *showAppOpts) Ask() error {
 
 // Execute writes the application's description.
 func (o *showAppOpts) Execute() error {
-	description, err := o.description()
-	if err != nil {
+	if err := o.initAppDescriber(); err != nil {
 		return err
 	}
+	description, err := o.describer.Describe()
+	if err != nil {
+		return fmt.Errorf("describe application %s: %w", o.name, err)
+	}
+	if err := description.Validate(); err != nil {
+		log.Warningln(err.Error())
+	}
+	opts := o.describer.RenderOptions()
+	opts.EnvRegion = o.region
 	if !o.shouldOutputJSON {
-		fmt.Fprint(o.w, description.HumanString())
+		if err := description.WriteHumanTo(o.w, opts); err != nil {
+			return fmt.Errorf("write human output: %w", err)
+		}
 		return nil
 	}
-	data, err := description.JSONString()
+	data, err := description.JSONStringWithOptions(opts)
 	if err != nil {
 		return fmt.Errorf("get JSON string: %w", err)
 	}
@@ -99,53 +133,6 @@ func (o *showAppOpts) Execute() error {
 	return nil
 }
 
-func (o *showAppOpts) description() (*describe.App, error) {
-	app, err := o.store.GetApplication(o.name)
-	if err != nil {
-		return nil, fmt.Errorf("get application %s: %w", o.name, err)
-	}
-	envs, err := o.store.ListEnvironments(o.name)
-	if err != nil {
-		return nil, fmt.Errorf("list environments in application %s: %w", o.name, err)
-	}
-	svcs, err := o.store.ListServices(o.name)
-	if err != nil {
-		return nil, fmt.Errorf("list services in application %s: %w", o.name, err)
-	}
-
-	pipelines, err := o.pipelineSvc.GetPipelinesByTags(map[string]string{
-		deploy.AppTagKey: o.name,
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("list pipelines in application %s: %w", o.name, err)
-	}
-
-	var trimmedEnvs []*config.Environment
-	for _, env := range envs {
-		trimmedEnvs = append(trimmedEnvs, &config.Environment{
-			Name:      env.Name,
-			AccountID: env.AccountID,
-			Region:    env.Region,
-			Prod:      env.Prod,
-		})
-	}
-	var trimmedSvcs []*config.Workload
-	for _, svc := range svcs {
-		trimmedSvcs = append(trimmedSvcs, &config.Workload{
-			Name: svc.Name,
-			Type: svc.Type,
-		})
-	}
-	return &describe.App{
-		Name:      app.Name,
-		URI:       app.Domain,
-		Envs:      trimmedEnvs,
-		Services:  trimmedSvcs,
-		Pipelines: pipelines,
-	}, nil
-}
-
 func (o *showAppOpts) askName() error {
 	if o.name != "" {
 		return nil
@@ -188,6 +175,10 @@ func buildAppShowCmd() *cobra.Command {
 	}
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, appResourcesFlagDescription)
+	cmd.Flags().StringVar(&vars.region, regionFlag, "", appRegionFlagDescription)
+	cmd.Flags().BoolVar(&vars.bestEffort, bestEffortFlag, false, appBestEffortFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputConsole, consoleFlag, false, appConsoleFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
 	return cmd
 }
@@ -12,15 +12,16 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
 type showAppMocks struct {
-	storeSvc    *mocks.Mockstore
-	prompt      *mocks.Mockprompter
-	sel         *mocks.MockappSelector
-	pipelineSvc *mocks.MockpipelineGetter
+	storeSvc  *mocks.Mockstore
+	prompt    *mocks.Mockprompter
+	sel       *mocks.MockappSelector
+	describer *mocks.MockappDescriber
 }
 
 func TestShowAppOpts_Validate(t *testing.T) {
@@ -160,8 +161,48 @@ func TestShowAppOpts_Ask(t *testing.T) {
 func TestShowAppOpts_Execute(t *testing.T) {
 	testAppName := "my-app"
 	testError := errors.New("some error")
+	testApp := &describe.App{
+		Name: "my-app",
+		URI:  "example.com",
+		Envs: []*describe.EnvSummary{
+			{
+				Environment: &config.Environment{
+					Name:      "test",
+					Region:    "us-west-2",
+					AccountID: "123456789",
+					Prod:      false,
+				},
+			},
+			{
+				Environment: &config.Environment{
+					Name:      "prod",
+					AccountID: "123456789",
+					Region:    "us-west-1",
+					Prod:      true,
+				},
+				Managed: true,
+			},
+		},
+		Services: []*describe.ServiceSummary{
+			{
+				Workload: &config.Workload{
+					Name: "my-svc",
+					Type: "lb-web-svc",
+				},
+			},
+		},
+		Deployments: map[string][]string{
+			"test": {"my-svc"},
+			"prod": {},
+		},
+		Pipelines: []*describe.PipelineSummary{
+			{Pipeline: &codepipeline.Pipeline{Name: "pipeline1"}},
+			{Pipeline: &codepipeline.Pipeline{Name: "pipeline2"}},
+		},
+	}
 	testCases := map[string]struct {
 		shouldOutputJSON bool
+		inRegion         string
 
 		setupMocks func(mocks showAppMocks)
 
@@ -172,174 +213,108 @@ func TestShowAppOpts_Execute(t *testing.T) {
 			shouldOutputJSON: true,
 
 			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
-					Name:   "my-app",
-					Domain: "example.com",
-				}, nil)
-				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
-					{
-						Name: "my-svc",
-						Type: "lb-web-svc",
-					},
-				}, nil)
-				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
-					{
-						Name:      "test",
-						Region:    "us-west-2",
-						AccountID: "123456789",
-						Prod:      false,
-					},
-					{
-						Name:      "prod",
-						AccountID: "123456789",
-						Region:    "us-west-1",
-						Prod:      true,
-					},
-				}, nil)
-				m.pipelineSvc.EXPECT().
-					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
-					Return([]*codepipeline.Pipeline{
-						{Name: "pipeline1"},
-						{Name: "pipeline2"},
-					}, nil)
+				m.describer.EXPECT().Describe().Return(testApp, nil)
+				m.describer.EXPECT().RenderOptions().Return(describe.AppRenderOptions{})
 			},
 
-			wantedContent: "{\"name\":\"my-app\",\"uri\":\"example.com\",\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"prod\",\"region\":\"us-west-1\",\"accountID\":\"123456789\",\"prod\":true,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}],\"services\":[{\"app\":\"\",\"name\":\"my-svc\",\"type\":\"lb-web-svc\"}],\"pipelines\":[{\"name\":\"pipeline1\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"name\":\"pipeline2\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}]}\n",
+			wantedContent: "{\"schemaVersion\":\"2023-10-01\",\"name\":\"my-app\",\"uri\":\"example.com\",\"environments\":[{\"app\":\"\",\"name\":\"prod\",\"region\":\"us-west-1\",\"accountID\":\"123456789\",\"prod\":true,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\",\"managed\":true},{\"app\":\"\",\"name\":\"test\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\",\"managed\":false}],\"services\":[{\"app\":\"\",\"name\":\"my-svc\",\"type\":\"lb-web-svc\"}],\"deployments\":{\"prod\":[],\"test\":[\"my-svc\"]},\"pipelines\":[{\"name\":\"pipeline1\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"name\":\"pipeline2\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}]}\n",
 		},
 		"correctly shows human output": {
 			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
-					Name:   "my-app",
-					Domain: "example.com",
-				}, nil)
-				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
-					{
-						Name: "my-svc",
-						Type: "lb-web-svc",
-					},
-				}, nil)
-				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
-					{
-						Name:      "test",
-						Region:    "us-west-2",
-						AccountID: "123456789",
-					},
-					{
-						Name:      "prod",
-						AccountID: "123456789",
-						Region:    "us-west-1",
-					},
-				}, nil)
-				m.pipelineSvc.EXPECT().
-					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
-					Return([]*codepipeline.Pipeline{
-						{Name: "pipeline1"},
-						{Name: "pipeline2"},
-					}, nil)
+				m.describer.EXPECT().Describe().Return(testApp, nil)
+				m.describer.EXPECT().RenderOptions().Return(describe.AppRenderOptions{})
 			},
 
 			wantedContent: `About
 
   Name              my-app
-  URI               example.com
+  URI               alias: example.com
+
+Environments (2)
 
-Environments
+  Name              AccountID           Region              Managed
+  ----              ---------           ------              -------
+  prod              123456789           us-west-1           ✓
+  test              123456789           us-west-2           ✗
 
-  Name              AccountID           Region
-  ----              ---------           ------
-  test              123456789           us-west-2
-  prod              123456789           us-west-1
+  Regions: us-west-1 (1), us-west-2 (1)
 
-Services
+Services (1)
 
   Name              Type
   ----              ----
   my-svc            lb-web-svc
 
-Pipelines
+Deployments
+
+  Name              prod                test
+  ----              ----                ----
+  my-svc            -                   ✔
 
-  Name
-  ----
-  pipeline1
-  pipeline2
+Pipelines (2)
+
+  Name              Repository          Branch              LatestStatus
+  ----              ----------          ------              ------------
+  pipeline1         -                   -                   -
+  pipeline2         -                   -                   -
 `,
 		},
-		"returns error if fail to get application": {
-			shouldOutputJSON: false,
+		"shows only the environments in the region": {
+			inRegion: "us-west-2",
 
 			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(nil, testError)
+				m.describer.EXPECT().Describe().Return(testApp, nil)
+				m.describer.EXPECT().RenderOptions().Return(describe.AppRenderOptions{})
 			},
 
-			wantedError: fmt.Errorf("get application %s: %w", "my-app", testError),
-		},
-		"returns error if fail to list environment": {
-			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
-					Name:   "my-app",
-					Domain: "example.com",
-				}, nil)
-				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, testError)
-			},
+			wantedContent: `About
+
+  Name              my-app
+  URI               alias: example.com
+
+Environments (1)
+
+  Name              AccountID           Region              Managed
+  ----              ---------           ------              -------
+  test              123456789           us-west-2           ✗
+
+Services (1)
+
+  Name              Type
+  ----              ----
+  my-svc            lb-web-svc
+
+Deployments
+
+  Name              test
+  ----              ----
+  my-svc            ✔
 
-			wantedError: fmt.Errorf("list environments in application %s: %w", "my-app", testError),
+Pipelines (2)
+
+  Name              Repository          Branch              LatestStatus
+  ----              ----------          ------              ------------
+  pipeline1         -                   -                   -
+  pipeline2         -                   -                   -
+`,
 		},
-		"returns error if fail to list services": {
-			shouldOutputJSON: false,
+		"shows only the environments in the region in json output": {
+			shouldOutputJSON: true,
+			inRegion:         "us-west-2",
 
 			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
-					Name:   "my-app",
-					Domain: "example.com",
-				}, nil)
-				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
-					{
-						Name:      "test",
-						Region:    "us-west-2",
-						AccountID: "123456789",
-					},
-					{
-						Name:      "prod",
-						AccountID: "123456789",
-						Region:    "us-west-1",
-					},
-				}, nil)
-				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, testError)
+				m.describer.EXPECT().Describe().Return(testApp, nil)
+				m.describer.EXPECT().RenderOptions().Return(describe.AppRenderOptions{})
 			},
 
-			wantedError: fmt.Errorf("list services in application %s: %w", "my-app", testError),
+			wantedContent: "{\"schemaVersion\":\"2023-10-01\",\"name\":\"my-app\",\"uri\":\"example.com\",\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\",\"managed\":false}],\"services\":[{\"app\":\"\",\"name\":\"my-svc\",\"type\":\"lb-web-svc\"}],\"deployments\":{\"test\":[\"my-svc\"]},\"pipelines\":[{\"name\":\"pipeline1\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"name\":\"pipeline2\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}]}\n",
 		},
-		"returns error if fail to list pipelines": {
-			shouldOutputJSON: false,
-
+		"returns error if fail to describe application": {
 			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
-					Name:   "my-app",
-					Domain: "example.com",
-				}, nil)
-				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
-					{
-						Name:      "test",
-						Region:    "us-west-2",
-						AccountID: "123456789",
-					},
-					{
-						Name:      "prod",
-						AccountID: "123456789",
-						Region:    "us-west-1",
-					},
-				}, nil)
-				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
-					{
-						Name: "my-svc",
-						Type: "lb-web-svc",
-					},
-				}, nil)
-				m.pipelineSvc.EXPECT().
-					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
-					Return(nil, testError)
+				m.describer.EXPECT().Describe().Return(nil, testError)
 			},
-			wantedError: fmt.Errorf("list pipelines in application %s: %w", "my-app", testError),
+
+			wantedError: fmt.Errorf("describe application %s: %w", "my-app", testError),
 		},
 	}
 
@@ -349,12 +324,10 @@ Pipelines
 			defer ctrl.Finish()
 
 			b := &bytes.Buffer{}
-			mockStoreReader := mocks.NewMockstore(ctrl)
-			mockPLSvc := mocks.NewMockpipelineGetter(ctrl)
+			mockDescriber := mocks.NewMockappDescriber(ctrl)
 
 			mocks := showAppMocks{
-				storeSvc:    mockStoreReader,
-				pipelineSvc: mockPLSvc,
+				describer: mockDescriber,
 			}
 			tc.setupMocks(mocks)
 
@@ -362,10 +335,11 @@ Pipelines
 				showAppVars: showAppVars{
 					shouldOutputJSON: tc.shouldOutputJSON,
 					name:             testAppName,
+					region:           tc.inRegion,
 				},
-				store:       mockStoreReader,
-				w:           b,
-				pipelineSvc: mockPLSvc,
+				w:                b,
+				initAppDescriber: func() error { return nil },
+				describer:        mockDescriber,
 			}
 
 			// WHEN
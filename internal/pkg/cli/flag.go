@@ -40,6 +40,8 @@ const (
 	prodEnvFlag           = "prod"
 	deployFlag            = "deploy"
 	resourcesFlag         = "resources"
+	bestEffortFlag        = "best-effort"
+	consoleFlag           = "console"
 	githubURLFlag         = "github-url"
 	repoURLFlag           = "url"
 	githubAccessTokenFlag = "github-access-token"
@@ -185,6 +187,11 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
 	domainNameFlagDescription        = "Optional. Your existing custom domain name."
+	appResourcesFlagDescription      = "Optional. Show the CloudFormation stack and stack set of your application."
+	appRegionFlagDescription         = "Optional. Only show the environments in this AWS region."
+	appBestEffortFlagDescription     = `Optional. Show the rest of your application with a warning
+if its services or pipelines can't be listed.`
+	appConsoleFlagDescription        = "Optional. Show the links to the AWS console pages of your application."
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
 	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
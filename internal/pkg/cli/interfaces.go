@@ -398,6 +398,11 @@ type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 }
 
+type appDescriber interface {
+	Describe() (*describe.App, error)
+	RenderOptions() describe.AppRenderOptions
+}
+
 type versionGetter interface {
 	Version() (string, error)
 }
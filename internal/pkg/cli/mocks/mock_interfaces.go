@@ -4178,6 +4178,58 @@ func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
 }
 
+// MockappDescriber is a mock of appDescriber interface.
+type MockappDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockappDescriberMockRecorder
+}
+
+// MockappDescriberMockRecorder is the mock recorder for MockappDescriber.
+type MockappDescriberMockRecorder struct {
+	mock *MockappDescriber
+}
+
+// NewMockappDescriber creates a new mock instance.
+func NewMockappDescriber(ctrl *gomock.Controller) *MockappDescriber {
+	mock := &MockappDescriber{ctrl: ctrl}
+	mock.recorder = &MockappDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappDescriber) EXPECT() *MockappDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockappDescriber) Describe() (*describe.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockappDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockappDescriber)(nil).Describe))
+}
+
+// RenderOptions mocks base method.
+func (m *MockappDescriber) RenderOptions() describe.AppRenderOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderOptions")
+	ret0, _ := ret[0].(describe.AppRenderOptions)
+	return ret0
+}
+
+// RenderOptions indicates an expected call of RenderOptions.
+func (mr *MockappDescriberMockRecorder) RenderOptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderOptions", reflect.TypeOf((*MockappDescriber)(nil).RenderOptions))
+}
+
 // MockversionGetter is a mock of versionGetter interface.
 type MockversionGetter struct {
 	ctrl     *gomock.Controller
@@ -19,6 +19,7 @@ type Application struct {
 	Domain             string            `json:"domain"`             // Existing domain name in Route53. An empty domain name means the user does not have one.
 	DomainHostedZoneID string            `json:"domainHostedZoneID"` // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
 	Version            string            `json:"version"`            // The version of the app layout in the underlying datastore (e.g. SSM).
+	Region             string            `json:"region,omitempty"`   // Home region of the app. Empty for apps created before the region was recorded.
 	Tags               map[string]string `json:"tags,omitempty"`     // Labels to apply to resources created within the app.
 }
 
@@ -14,6 +14,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
@@ -66,6 +67,15 @@ func NewStore() (*Store, error) {
 	}, nil
 }
 
+// NewStoreFromSession returns a new store that makes API calls with the given session instead of the default one.
+func NewStoreFromSession(sess *session.Session) *Store {
+	return &Store{
+		idClient:      identity.New(sess),
+		ssmClient:     ssm.New(sess),
+		sessionRegion: aws.StringValue(sess.Config.Region),
+	}
+}
+
 func (s *Store) listParams(path string) ([]*string, error) {
 	var serializedParams []*string
 
@@ -40,8 +40,8 @@ const (
 	EnvOutputVPCID               = "VpcId"
 	EnvOutputPublicSubnets       = "PublicSubnets"
 	EnvOutputPrivateSubnets      = "PrivateSubnets"
-	envOutputCFNExecutionRoleARN = "CFNExecutionRoleARN"
-	envOutputManagerRoleKey      = "EnvironmentManagerRoleARN"
+	EnvOutputCFNExecutionRoleARN = "CFNExecutionRoleARN"
+	EnvOutputManagerRoleKey      = "EnvironmentManagerRoleARN"
 
 	// Default parameter values
 	DefaultVPCCIDR            = "10.0.0.0/16"
@@ -169,7 +169,7 @@ func (e *EnvStackConfig) ToEnv(stack *cloudformation.Stack) (*config.Environment
 		Prod:             e.in.Prod,
 		Region:           stackARN.Region,
 		AccountID:        stackARN.AccountID,
-		ManagerRoleARN:   stackOutputs[envOutputManagerRoleKey],
-		ExecutionRoleARN: stackOutputs[envOutputCFNExecutionRoleARN],
+		ManagerRoleARN:   stackOutputs[EnvOutputManagerRoleKey],
+		ExecutionRoleARN: stackOutputs[EnvOutputCFNExecutionRoleARN],
 	}, nil
 }
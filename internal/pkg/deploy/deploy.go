@@ -71,6 +71,45 @@ func NewStore(store ConfigStoreClient) (*Store, error) {
 	return s, nil
 }
 
+// NewStoreFromRole returns a new store that lists the deployed services of every environment by assuming roleARN
+// in the environment's region, instead of the environment manager role.
+func NewStoreFromRole(store ConfigStoreClient, roleARN string) (*Store, error) {
+	s, err := NewStore(store)
+	if err != nil {
+		return nil, err
+	}
+	s.newRgClientFromIDs = func(appName, envName string) (resourceGetter, error) {
+		env, err := s.configStore.GetEnvironment(appName, envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment config %s: %w", envName, err)
+		}
+		return s.newRgClientFromRole(roleARN, env.Region)
+	}
+	return s, nil
+}
+
+// NewStoreFromRoleChain returns a new store that lists the deployed services of every environment by assuming the
+// environment manager role at the end of the chain of roleARNs, for applications that are only reachable through the chain.
+func NewStoreFromRoleChain(store ConfigStoreClient, roleARNs []string) (*Store, error) {
+	s, err := NewStore(store)
+	if err != nil {
+		return nil, err
+	}
+	s.newRgClientFromIDs = func(appName, envName string) (resourceGetter, error) {
+		env, err := s.configStore.GetEnvironment(appName, envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment config %s: %w", envName, err)
+		}
+		chain := append(append([]string{}, roleARNs...), env.ManagerRoleARN)
+		sess, err := sessions.NewProvider().FromRoleChain(chain, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("create new session from env role: %w", err)
+		}
+		return rg.New(sess), nil
+	}
+	return s, nil
+}
+
 // ListDeployedServices returns the names of deployed services in an environment part of an application.
 func (s *Store) ListDeployedServices(appName string, envName string) ([]string, error) {
 	rgClient, err := s.newRgClientFromIDs(appName, envName)
@@ -5,135 +5,2316 @@ package describe
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"text/tabwriter"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"golang.org/x/mod/semver"
+	"golang.org/x/sync/errgroup"
 	"gopkg.in/yaml.v3"
 )
 
 // App contains serialized parameters for an application.
 type App struct {
-	Name      string                   `json:"name"`
-	URI       string                   `json:"uri"`
-	Envs      []*config.Environment    `json:"environments"`
-	Services  []*config.Workload       `json:"services"`
-	Pipelines []*codepipeline.Pipeline `json:"pipelines"`
+	Name            string                 `json:"name"`
+	URI             string                 `json:"uri,omitempty"`
+	Envs            []*EnvSummary          `json:"environments"`
+	Services        []*ServiceSummary      `json:"services"`
+	Deployments     map[string][]string    `json:"deployments,omitempty"` // Environment name to the names of the services deployed in it.
+	Pipelines       []*PipelineSummary     `json:"pipelines"`
+	StackARN        string                 `json:"stackARN,omitempty"`
+	StackSetARN     string                 `json:"stackSetARN,omitempty"`
+	CreationTime    *time.Time             `json:"creationTime,omitempty"`
+	LastUpdatedTime *time.Time             `json:"lastUpdatedTime,omitempty"`
+	Warnings        []string               `json:"warnings,omitempty"`
+	Console         *AppConsoleURLs        `json:"console,omitempty"`     // Links to the AWS console, only set with WithConsoleURLs.
+	DriftStatus     string                 `json:"driftStatus,omitempty"` // Drift of the app stack, only detected with WithDriftDetection.
+	Extra           map[string]interface{} `json:"extra,omitempty"`       // Custom fields set by the enrichers of WithEnrichers.
+	DryRun          bool                   `json:"dryRun,omitempty"`      // Set if the description was built from the config store only with WithDryRun.
+
+	CachedAt *time.Time `json:"-"` // Time the application was described at if it was loaded with LoadAppFromFile.
+}
+
+// EnvSummary contains serialized parameters for an environment of an application.
+type EnvSummary struct {
+	*config.Environment
+	Managed bool              `json:"managed"`          // True if the environment's account and region are part of the app stack set.
+	Tags    map[string]string `json:"tags,omitempty"`   // Tags of the environment stack, only retrieved with WithEnvironmentTags.
+	Status  string            `json:"status,omitempty"` // Health of the environment stack, only retrieved with WithEnvironmentStatus.
+
+	CreationTime         *time.Time     `json:"creationTime,omitempty"`         // Creation time of the environment stack, only retrieved with WithEnvironmentsSortedByAge.
+	TemplateVersion      string         `json:"templateVersion,omitempty"`      // Version of the environment template, only retrieved with WithEnvironmentVersions.
+	Endpoint             string         `json:"endpoint,omitempty"`             // Domain or load balancer DNS name of the environment, only resolved with WithEnvironmentEndpoints.
+	EstimatedMonthlyCost *EstimatedCost `json:"estimatedMonthlyCost,omitempty"` // Only estimated with WithCostEstimate.
+	VPC                  *EnvVPC        `json:"vpc,omitempty"`                  // Only retrieved with WithEnvironmentNetworking.
+}
+
+// ServiceSummary contains serialized parameters for a service of an application.
+type ServiceSummary struct {
+	*config.Workload
+	URLs     map[string]string `json:"urls,omitempty"`     // Environment name to the URL of the service, only resolved for Load Balanced Web Services with WithServiceURLs.
+	Rollouts map[string]string `json:"rollouts,omitempty"` // Environment name to the rollout strategy of the service, only retrieved with WithServiceRollouts.
+
+	DeployedEnvs []string   `json:"deployedEnvironments,omitempty"` // Names of the environments the service is deployed to, only listed with WithServiceCoverage.
+	LastDeployed *time.Time `json:"lastDeployed,omitempty"`         // Most recent update of the service stacks, only retrieved with WithServiceLastDeployed.
+}
+
+// PipelineSummary contains serialized parameters for a pipeline of an application.
+type PipelineSummary struct {
+	*codepipeline.Pipeline
+	Status       string           `json:"status,omitempty"`       // Status of the latest execution of the pipeline, such as "Succeeded", "Failed" or "InProgress".
+	StageActions []*PipelineStage `json:"stageActions,omitempty"` // Actions of each stage of the pipeline, only set with WithPipelineStages.
+}
+
+// PipelineStage holds the actions of a stage of a pipeline.
+type PipelineStage struct {
+	Name    string            `json:"name"`
+	Actions []*PipelineAction `json:"actions"`
+}
+
+// PipelineAction holds an action of a pipeline stage, such as a source, build or deploy step.
+type PipelineAction struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Provider string `json:"provider"`
+	Details  string `json:"details,omitempty"`
+}
+
+const maxDomainNameLength = 253
+
+// domainNameRegexp matches a fully qualified domain name made of dot-separated labels of letters, digits and hyphens.
+var domainNameRegexp = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// Normalize trims the application URI and attaches a warning to the description if the URI is malformed.
+// Custom domains are stored without a scheme, so a bare domain such as "example.com" is valid.
+// A URI with a scheme must be an HTTP(S) URL with a host.
+func (a *App) Normalize() {
+	a.URI = strings.TrimSpace(a.URI)
+	if a.URI == "" {
+		return
+	}
+	if !strings.Contains(a.URI, "://") {
+		if len(a.URI) > maxDomainNameLength || !domainNameRegexp.MatchString(a.URI) {
+			a.Warnings = append(a.Warnings, fmt.Sprintf("URI %s is not a valid domain name", a.URI))
+		}
+		return
+	}
+	u, err := url.Parse(a.URI)
+	switch {
+	case err != nil || u.Host == "":
+		a.Warnings = append(a.Warnings, fmt.Sprintf("URI %s is not a valid URL", a.URI))
+	case u.Scheme != "http" && u.Scheme != "https":
+		a.Warnings = append(a.Warnings, fmt.Sprintf("URI %s has scheme %s instead of http or https", a.URI, u.Scheme))
+	}
+}
+
+// AppJSONSchemaVersion is the value of the top-level "schemaVersion" field of the JSON representation of an App.
+// It is bumped to the date of the change only when the shape of the JSON output changes in a way that
+// parsers can't ignore, such as a field that is renamed, removed or whose type changes. Adding a field doesn't bump it.
+const AppJSONSchemaVersion = "2023-10-01"
+
+// MarshalJSON implements the json.Marshaler interface.
+// Environments are sorted by name, and services are sorted by name then type so that the output is stable.
+// The output starts with a "schemaVersion" field set to AppJSONSchemaVersion.
+// Environments, services and pipelines are serialized as empty arrays rather than null when there are none.
+// The App is serialized with the zero AppRenderOptions, see JSONStringWithOptions to render it otherwise.
+func (a *App) MarshalJSON() ([]byte, error) {
+	return a.view(AppRenderOptions{}).marshalData()
+}
+
+// JSONString returns the stringified App struct with json format, rendered with the zero AppRenderOptions.
+func (a *App) JSONString() (string, error) {
+	return a.JSONStringWithOptions(AppRenderOptions{})
+}
+
+// JSONStringWithOptions returns the stringified App struct with json format rendered according to opts.
+func (a *App) JSONStringWithOptions(opts AppRenderOptions) (string, error) {
+	b, err := a.view(opts).marshalJSON()
+	if err != nil {
+		return "", fmt.Errorf("marshal application description: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// JSONStringIndent returns the stringified App struct with json format indented by two spaces.
+// It contains the same fields as JSONStringWithOptions and is meant to be read by humans.
+func (a *App) JSONStringIndent(opts AppRenderOptions) (string, error) {
+	b, err := a.view(opts).marshalJSON()
+	if err != nil {
+		return "", fmt.Errorf("marshal application description: %w", err)
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, b, "", "  "); err != nil {
+		return "", fmt.Errorf("indent application description: %w", err)
+	}
+	return fmt.Sprintf("%s\n", out.Bytes()), nil
+}
+
+// YAMLString returns the stringified App struct with yaml format rendered according to opts.
+// The keys match the ones used in JSONString whatever the OutputProfile of opts. The output is deterministic:
+// it is indented with two spaces, the keys of maps are sorted, and it never has anchors nor aliases.
+func (a *App) YAMLString(opts AppRenderOptions) (string, error) {
+	data, err := a.view(opts).marshalData()
+	if err != nil {
+		return "", fmt.Errorf("marshal application description: %w", err)
+	}
+	b, err := marshalYAML(json.RawMessage(data))
+	if err != nil {
+		return "", fmt.Errorf("marshal application description: %w", err)
+	}
+	return string(b), nil
+}
+
+// AppSection is a section of the human readable application description.
+type AppSection int
+
+// Sections of the human readable application description.
+const (
+	SectionAbout AppSection = iota + 1
+	SectionEnvironments
+	SectionServices
+	SectionDeployments
+	SectionPipelines
+	SectionWarnings
+	SectionResources
+	SectionConsole
+)
+
+// appSections lists all sections in the order that they are rendered.
+var appSections = []AppSection{SectionAbout, SectionEnvironments, SectionServices, SectionDeployments, SectionPipelines, SectionResources, SectionConsole, SectionWarnings}
+
+// HumanString returns the stringified App struct with human readable format, rendered with the zero AppRenderOptions.
+// Section headers are emphasized unless colors are disabled, for example with the COLOR environment variable.
+func (a *App) HumanString() string {
+	return a.HumanStringWithOptions(AppRenderOptions{})
+}
+
+// OneLineSummary returns a single line describing the App, such as
+// "phonetool: 3 envs, 14 svcs, 2 pipelines (uri: example.com)", for listing many applications.
+// The URI is omitted if the application doesn't have one.
+func (a *App) OneLineSummary() string {
+	summary := fmt.Sprintf("%s: %s, %s, %s", a.Name,
+		countOf(len(a.Envs), "env", "envs"),
+		countOf(len(a.Services), "svc", "svcs"),
+		countOf(len(a.Pipelines), "pipeline", "pipelines"))
+	if a.URI == "" {
+		return summary
+	}
+	return fmt.Sprintf("%s (uri: %s)", summary, a.URI)
+}
+
+func countOf(n int, singular, plural string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
+
+// WriteHumanTo writes the App struct with human readable format to w, rendered according to opts.
+// The output is identical to HumanStringWithOptions. It returns the first error encountered while writing to w.
+func (a *App) WriteHumanTo(w io.Writer, opts AppRenderOptions) error {
+	return a.view(opts).writeHuman(w)
+}
+
+// HumanStringOptions overrides the layout of the tables in the human readable application description.
+// Zero values fall back to the layout used by HumanString.
+type HumanStringOptions struct {
+	MinCellWidth int  // Minimum number of characters in a table's cell, including padding.
+	CellPadding  int  // Number of padding characters added to a cell.
+	PaddingChar  byte // Character used to pad cells.
+}
+
+func (opts HumanStringOptions) withDefaults() HumanStringOptions {
+	if opts.MinCellWidth == 0 {
+		opts.MinCellWidth = minCellWidth
+	}
+	if opts.CellPadding == 0 {
+		opts.CellPadding = cellPaddingWidth
+	}
+	if opts.PaddingChar == 0 {
+		opts.PaddingChar = paddingChar
+	}
+	return opts
+}
+
+// HumanStringWithOptions returns the stringified App struct with human readable format, like HumanString,
+// but rendered according to opts.
+func (a *App) HumanStringWithOptions(opts AppRenderOptions) string {
+	var b bytes.Buffer
+	// Writing to a bytes.Buffer never fails.
+	_ = a.WriteHumanTo(&b, opts)
+	return b.String()
+}
+
+// HumanStringSections returns the stringified App struct with human readable format
+// containing only the given sections. If no section is provided, all sections are included.
+// Environments and services are listed in the same order as in JSONString.
+// The Deployments section is only rendered if the deployments of the application are known,
+// the Resources section is only rendered if the stack ARNs of the application are known,
+// the Console section is only rendered if the links to the AWS console of the application are known,
+// and the Warnings section is only rendered if there are any warnings.
+// The headers of the Environments, Services and Pipelines sections include the number of items listed.
+// When there are several environments, the Environments section ends with the number of environments per region.
+// When the environments span several accounts, the About section lists them.
+func (a *App) HumanStringSections(sections ...AppSection) string {
+	return a.HumanStringWithOptions(AppRenderOptions{Sections: sections})
+}
+
+func (a *appView) writeHuman(w io.Writer) error {
+	sections := a.Sections
+	if len(sections) == 0 {
+		sections = appSections
+	}
+	included := make(map[AppSection]bool)
+	for _, section := range sections {
+		included[section] = true
+	}
+
+	ew := &errWriter{w: w}
+	layout := a.HumanStringOptions.withDefaults()
+	writer := tabwriter.NewWriter(ew, layout.MinCellWidth, tabWidth, layout.CellPadding, layout.PaddingChar, noAdditionalFormatting)
+	first := true
+	for _, section := range appSections {
+		if !included[section] {
+			continue
+		}
+		if section == SectionDeployments && a.Deployments == nil {
+			continue
+		}
+		if section == SectionResources && a.StackARN == "" && a.StackSetARN == "" {
+			continue
+		}
+		if section == SectionConsole && a.Console == nil {
+			continue
+		}
+		if section == SectionWarnings && len(a.Warnings) == 0 {
+			continue
+		}
+		prefix := "\n"
+		if first {
+			prefix = ""
+			first = false
+		}
+		switch section {
+		case SectionAbout:
+			fmt.Fprint(writer, color.Bold.Sprint(prefix+a.translate("About")+"\n\n"))
+			writer.Flush()
+			a.writeAbout(writer)
+		case SectionEnvironments:
+			fmt.Fprint(writer, color.Bold.Sprintf("%s%s (%d)\n\n", prefix, a.translate("Environments"), len(a.Envs)))
+			writer.Flush()
+			a.writeEnvs(writer)
+		case SectionServices:
+			fmt.Fprint(writer, color.Bold.Sprintf("%s%s (%d)\n\n", prefix, a.translate("Services"), len(a.Services)))
+			writer.Flush()
+			a.writeServices(writer)
+		case SectionDeployments:
+			fmt.Fprint(writer, color.Bold.Sprint(prefix+a.translate("Deployments")+"\n\n"))
+			writer.Flush()
+			a.writeDeployments(writer)
+		case SectionPipelines:
+			fmt.Fprint(writer, color.Bold.Sprintf("%s%s (%d)\n\n", prefix, a.translate("Pipelines"), len(a.Pipelines)))
+			writer.Flush()
+			a.writePipelines(writer)
+		case SectionResources:
+			fmt.Fprint(writer, color.Bold.Sprint(prefix+a.translate("Resources")+"\n\n"))
+			writer.Flush()
+			a.writeResources(writer)
+		case SectionConsole:
+			fmt.Fprint(writer, color.Bold.Sprint(prefix+a.translate("Console")+"\n\n"))
+			writer.Flush()
+			a.writeConsole(writer)
+		case SectionWarnings:
+			fmt.Fprint(writer, color.BoldFgYellow.Sprint(prefix+a.translate("Warnings")+"\n\n"))
+			writer.Flush()
+			a.writeWarnings(writer)
+		}
+	}
+	writer.Flush()
+	return ew.err
+}
+
+// errWriter is an io.Writer that records the first error returned by the underlying writer,
+// after which all writes are discarded.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+// Write implements the io.Writer interface.
+func (ew *errWriter) Write(p []byte) (int, error) {
+	if ew.err != nil {
+		return 0, ew.err
+	}
+	n, err := ew.w.Write(p)
+	ew.err = err
+	return n, err
+}
+
+// sorted returns a shallow copy of the App with environments sorted by name, or from the oldest to the newest if byAge is set,
+// and services sorted by name then type.
+func (a *App) sorted(byAge bool) *App {
+	sorted := *a
+	if a.Envs != nil {
+		sorted.Envs = make([]*EnvSummary, len(a.Envs))
+		copy(sorted.Envs, a.Envs)
+		sort.SliceStable(sorted.Envs, func(i, j int) bool {
+			if byAge {
+				return sorted.Envs[i].isOlderThan(sorted.Envs[j])
+			}
+			return sorted.Envs[i].Name < sorted.Envs[j].Name
+		})
+	}
+	if a.Services != nil {
+		sorted.Services = make([]*ServiceSummary, len(a.Services))
+		copy(sorted.Services, a.Services)
+		sort.SliceStable(sorted.Services, func(i, j int) bool {
+			if sorted.Services[i].Name != sorted.Services[j].Name {
+				return sorted.Services[i].Name < sorted.Services[j].Name
+			}
+			return sorted.Services[i].Type < sorted.Services[j].Type
+		})
+	}
+	return &sorted
+}
+
+func (a *appView) writeAbout(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\n", a.translate("Name"), a.Name)
+	fmt.Fprintf(w, "  %s\t%s\n", a.translate("URI"), humanURI(a.URI))
+	if accounts := a.AccountIDs(); len(accounts) > 1 {
+		fmt.Fprintf(w, "  %s\t%s\n", a.translate("Accounts"), strings.Join(accounts, ", "))
+	}
+	if a.CreationTime != nil {
+		fmt.Fprintf(w, "  %s\t%s\n", a.translate("Created At"), humanizeTime(*a.CreationTime))
+	}
+	if a.LastUpdatedTime != nil {
+		fmt.Fprintf(w, "  %s\t%s\n", a.translate("Updated At"), humanizeTime(*a.LastUpdatedTime))
+	}
+	if a.CachedAt != nil {
+		fmt.Fprintf(w, "  %s\t%s\n", a.translate("Cached At"), humanizeTime(*a.CachedAt))
+	}
+	if a.DriftStatus != "" {
+		fmt.Fprintf(w, "  %s\t%s\n", a.translate("Drift"), a.DriftStatus)
+	}
+	if a.DryRun {
+		fmt.Fprintf(w, "  %s\t%s\n", a.translate("Version"), dryRunUnavailable)
+	}
+}
+
+// humanURI returns the URI as is if it is an HTTP(S) URL, and prefixes it with "alias:" if it is a bare domain
+// so that it isn't mistaken for a live endpoint.
+func humanURI(uri string) string {
+	if uri == "" {
+		return "(none)"
+	}
+	if u, err := url.Parse(uri); err == nil && u.Host != "" && (u.Scheme == "http" || u.Scheme == "https") {
+		return uri
+	}
+	if !strings.Contains(uri, "://") {
+		return "alias: " + uri
+	}
+	return uri
+}
+
+func (a *appView) writeEnvs(w io.Writer) {
+	headers, rows := a.envTable()
+	headers = a.translateAll(headers)
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for i, env := range a.Envs {
+		fmt.Fprintf(w, "  %s\n", strings.Join(rows[i], "\t"))
+		env.writeRoles(w, "    ")
+		env.writeVPC(w, "    ")
+	}
+	if len(a.Envs) > 1 {
+		fmt.Fprintf(w, "\n  %s: %s\n", a.translate("Regions"), strings.Join(a.regionCounts(), ", "))
+	}
+}
+
+// envTable returns the headers and the rows, one per environment, of the Environments section.
+// The optional columns are only present if some environment has a value for them.
+func (a *appView) envTable() (headers []string, rows [][]string) {
+	headers = []string{"Name", "AccountID", "Region", "Managed"}
+	withStatus, withCost, withCreationTime, withVersion := a.hasEnvStatus(), a.hasEnvCosts(), a.hasEnvCreationTimes(), a.hasEnvVersions()
+	withEndpoint := a.hasEnvEndpoints()
+	if withStatus {
+		headers = append(headers, "Status")
+	}
+	if withVersion {
+		headers = append(headers, "Version")
+	}
+	if withCreationTime {
+		headers = append(headers, "Created")
+	}
+	if withCost {
+		headers = append(headers, "EstCost")
+	}
+	if withEndpoint {
+		headers = append(headers, "Endpoint")
+	}
+	headers = append(headers, a.EnvTagColumns...)
+	for _, env := range a.Envs {
+		managed := a.managedMark(env)
+		row := []string{env.Name, env.AccountID, env.Region, managed}
+		if withStatus {
+			row = append(row, valueOrDash(env.Status))
+		}
+		if withVersion {
+			row = append(row, valueOrDash(env.TemplateVersion))
+		}
+		if withCreationTime {
+			created := "-"
+			if env.CreationTime != nil {
+				created = humanizeTime(*env.CreationTime)
+			}
+			row = append(row, created)
+		}
+		if withCost {
+			cost := "-"
+			if env.EstimatedMonthlyCost != nil {
+				cost = env.EstimatedMonthlyCost.String()
+			}
+			row = append(row, cost)
+		}
+		if withEndpoint {
+			row = append(row, valueOrDash(env.Endpoint))
+		}
+		for _, key := range a.EnvTagColumns {
+			row = append(row, valueOrDash(env.Tags[key]))
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows
+}
+
+// managedMark returns whether the environment is part of the app stack set, or a dash if it is unknown in dry-run mode.
+func (a *App) managedMark(env *EnvSummary) string {
+	switch {
+	case a.DryRun:
+		return "-"
+	case env.Managed:
+		return "✓"
+	default:
+		return "✗"
+	}
+}
+
+// writeRoles writes one bullet line per known role of the environment.
+func (e *EnvSummary) writeRoles(w io.Writer, indent string) {
+	if e.ManagerRoleARN != "" {
+		fmt.Fprintf(w, "%s- Manager Role: %s\n", indent, e.ManagerRoleARN)
+	}
+	if e.ExecutionRoleARN != "" {
+		fmt.Fprintf(w, "%s- Execution Role: %s\n", indent, e.ExecutionRoleARN)
+	}
+}
+
+// hasEnvEndpoints returns true if the endpoint of any environment is known.
+func (a *App) hasEnvEndpoints() bool {
+	for _, env := range a.Envs {
+		if env.Endpoint != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasEnvCreationTimes returns true if the creation time of any environment is known.
+func (a *App) hasEnvCreationTimes() bool {
+	for _, env := range a.Envs {
+		if env.CreationTime != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// isOlderThan returns true if the environment was created before other. Environments whose creation time is unknown
+// are sorted after all the others, and environments created at the same time are ordered by name.
+func (e *EnvSummary) isOlderThan(other *EnvSummary) bool {
+	switch {
+	case e.CreationTime == nil && other.CreationTime == nil:
+		return e.Name < other.Name
+	case e.CreationTime == nil:
+		return false
+	case other.CreationTime == nil:
+		return true
+	case e.CreationTime.Equal(*other.CreationTime):
+		return e.Name < other.Name
+	}
+	return e.CreationTime.Before(*other.CreationTime)
+}
+
+// hasEnvStatus returns true if the health of any environment is known.
+func (a *App) hasEnvStatus() bool {
+	for _, env := range a.Envs {
+		if env.Status != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// EnvsInRegion returns the environments of the application in the given region sorted by name.
+// It returns an empty slice if none of the environments are in the region.
+func (a *App) EnvsInRegion(region string) []*config.Environment {
+	envs := []*config.Environment{}
+	for _, env := range a.sorted(false).envSummariesInRegion(region) {
+		envs = append(envs, env.Environment)
+	}
+	return envs
+}
+
+func (a *App) envSummariesInRegion(region string) []*EnvSummary {
+	var envs []*EnvSummary
+	for _, env := range a.Envs {
+		if env.Region == region {
+			envs = append(envs, env)
+		}
+	}
+	return envs
+}
+
+// AccountIDs returns the sorted IDs of the AWS accounts that the environments of the application are in, without duplicates.
+func (a *App) AccountIDs() []string {
+	seen := make(map[string]bool)
+	var accounts []string
+	for _, env := range a.Envs {
+		if env.AccountID == "" || seen[env.AccountID] {
+			continue
+		}
+		seen[env.AccountID] = true
+		accounts = append(accounts, env.AccountID)
+	}
+	sort.Strings(accounts)
+	return accounts
+}
+
+// regionCounts returns the regions of the environments along with the number of environments in each of them,
+// for example "us-east-1 (2)". Regions with the most environments come first, ties are ordered by name.
+func (a *App) regionCounts() []string {
+	counts := make(map[string]int)
+	var regions []string
+	for _, env := range a.Envs {
+		if counts[env.Region] == 0 {
+			regions = append(regions, env.Region)
+		}
+		counts[env.Region]++
+	}
+	sort.SliceStable(regions, func(i, j int) bool {
+		if counts[regions[i]] != counts[regions[j]] {
+			return counts[regions[i]] > counts[regions[j]]
+		}
+		return regions[i] < regions[j]
+	})
+	summary := make([]string, len(regions))
+	for i, region := range regions {
+		summary[i] = fmt.Sprintf("%s (%d)", region, counts[region])
+	}
+	return summary
+}
+
+func (a *appView) writeServices(w io.Writer) {
+	if a.GroupServicesByType {
+		a.writeServicesByType(w)
+		return
+	}
+	headers, rows := a.svcTable()
+	headers = a.translateAll(headers)
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for i, svc := range a.Services {
+		fmt.Fprintf(w, "  %s\n", strings.Join(rows[i], "\t"))
+		svc.writeURLs(w, "    ")
+	}
+}
+
+// svcTable returns the headers and the rows, one per service, of the Services section.
+func (a *appView) svcTable() (headers []string, rows [][]string) {
+	headers = []string{"Name", "Type"}
+	withRollout := a.hasRollouts()
+	if withRollout {
+		headers = append(headers, "Rollout")
+	}
+	if a.ServiceCoverage {
+		headers = append(headers, "Coverage")
+	}
+	withLastDeployed := a.hasLastDeployed()
+	if withLastDeployed {
+		headers = append(headers, "LastDeployed")
+	}
+	for _, svc := range a.Services {
+		row := []string{svc.Name, svc.Type}
+		if withRollout {
+			row = append(row, svc.rollout())
+		}
+		if a.ServiceCoverage {
+			row = append(row, a.coverage(svc))
+		}
+		if withLastDeployed {
+			row = append(row, svc.lastDeployed())
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows
+}
+
+// writeURLs writes one bullet line per environment that the service has a URL in, sorted by environment name.
+func (s *ServiceSummary) writeURLs(w io.Writer, indent string) {
+	var envs []string
+	for env := range s.URLs {
+		envs = append(envs, env)
+	}
+	sort.Strings(envs)
+	for _, env := range envs {
+		fmt.Fprintf(w, "%s- %s: %s\n", indent, env, s.URLs[env])
+	}
+}
+
+// writeServicesByType writes the names of the services under a subheader for each service type.
+// Types are listed alphabetically, and services keep their order within a type.
+func (a *appView) writeServicesByType(w io.Writer) {
+	var types []string
+	svcsByType := make(map[string][]*ServiceSummary)
+	for _, svc := range a.Services {
+		if _, ok := svcsByType[svc.Type]; !ok {
+			types = append(types, svc.Type)
+		}
+		svcsByType[svc.Type] = append(svcsByType[svc.Type], svc)
+	}
+	sort.Strings(types)
+	for i, typ := range types {
+		if i > 0 {
+			fmt.Fprint(w, "\n")
+		}
+		fmt.Fprintf(w, "  %s\n", typ)
+		for _, svc := range svcsByType[typ] {
+			fmt.Fprintf(w, "    - %s\n", svc.Name)
+			svc.writeURLs(w, "      ")
+		}
+	}
+}
+
+// ServicesByType returns the workloads of the services keyed by their type, such as "Load Balanced Web Service".
+// The workloads of each type are sorted by name.
+func (a *App) ServicesByType() map[string][]*config.Workload {
+	svcsByType := make(map[string][]*config.Workload)
+	for _, svc := range a.sorted(false).Services {
+		svcsByType[svc.Type] = append(svcsByType[svc.Type], svc.Workload)
+	}
+	return svcsByType
+}
+
+// writeDeployments writes a matrix of services by environments marking where each service is deployed.
+func (a *appView) writeDeployments(w io.Writer) {
+	headers := []string{a.translate("Name")}
+	for _, env := range a.Envs {
+		headers = append(headers, env.Name)
+	}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, svc := range a.Services {
+		row := []string{svc.Name}
+		for _, env := range a.Envs {
+			cell := "-"
+			for _, deployed := range a.Deployments[env.Name] {
+				if deployed == svc.Name {
+					cell = "✔"
+					break
+				}
+			}
+			row = append(row, cell)
+		}
+		fmt.Fprintf(w, "  %s\n", strings.Join(row, "\t"))
+	}
+}
+
+func (a *appView) writePipelines(w io.Writer) {
+	headers := a.translateAll([]string{"Name", "Repository", "Branch", "LatestStatus"})
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, pipeline := range a.Pipelines {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", pipeline.Name, valueOrDash(pipeline.Repository), valueOrDash(pipeline.Branch), fmtPipelineStatus(pipeline.Status))
+		if a.hasPipelineActions() {
+			pipeline.writeStages(w, "    ")
+		}
+	}
+}
+
+// writeStages writes one bullet line per stage of the pipeline, followed by one nested bullet line per action of the stage.
+func (p *PipelineSummary) writeStages(w io.Writer, indent string) {
+	for _, stage := range p.StageActions {
+		fmt.Fprintf(w, "%s- %s\n", indent, stage.Name)
+		for _, action := range stage.Actions {
+			fmt.Fprintf(w, "%s  - %s (%s, %s)", indent, action.Name, action.Category, action.Provider)
+			if action.Details != "" {
+				fmt.Fprintf(w, ": %s", action.Details)
+			}
+			fmt.Fprintln(w)
+		}
+	}
+}
+
+// hasPipelineActions returns true if the actions of any pipeline stage are known.
+func (a *App) hasPipelineActions() bool {
+	for _, pipeline := range a.Pipelines {
+		for _, stage := range pipeline.StageActions {
+			if len(stage.Actions) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fmtPipelineStatus returns the status of a pipeline execution, colored in red if the execution failed.
+func fmtPipelineStatus(status string) string {
+	if status == "Failed" {
+		return color.Red.Sprint(status)
+	}
+	return valueOrDash(status)
+}
+
+func (a *appView) writeResources(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\n", a.translate("Stack"), valueOrDash(a.StackARN))
+	fmt.Fprintf(w, "  %s\t%s\n", a.translate("Stack set"), valueOrDash(a.StackSetARN))
+}
+
+func (a *appView) writeWarnings(w io.Writer) {
+	for _, warning := range a.Warnings {
+		fmt.Fprintf(w, "  - %s\n", warning)
+	}
+}
+
+// valueOrDash returns "-" if the value is empty.
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// AppConfigStore wraps methods of config store used to describe an application.
+type AppConfigStore interface {
+	GetApplication(appName string) (*config.Application, error)
+	ListEnvironments(appName string) ([]*config.Environment, error)
+	ListServices(appName string) ([]*config.Workload, error)
+}
+
+// DeployedServicesLister wraps the method of deploy store used to describe an application.
+type DeployedServicesLister interface {
+	ListDeployedServices(appName string, envName string) ([]string, error)
+}
+
+type stackDescriber interface {
+	Describe(stackName string) (*cloudformation.StackDescription, error)
+	TemplateBody(stackName string) (string, error)
+	Metadata(opt cloudformation.MetadataOpts) (string, error)
+	StackResources(name string) ([]*cloudformation.StackResource, error)
+}
+
+type driftDetector interface {
+	DetectDrift(ctx context.Context, stackName string) (string, error)
+}
+
+type costEstimator interface {
+	CostsByTag(tags map[string]string, groupBy string, start, end time.Time) (map[string]costexplorer.Cost, error)
+}
+
+type objectUploader interface {
+	PutObject(bucket, key, contentType string, data io.Reader) (string, error)
+}
+
+type webSvcURIDescriber interface {
+	URI(envName string) (string, error)
+}
+
+type pipelinesGetter interface {
+	GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error)
+	LatestExecutionStatus(pipelineName string) (string, error)
+}
+
+type stackSetDescriber interface {
+	Describe(name string) (stackset.Description, error)
+	InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error)
+}
+
+const (
+	defaultServiceConcurrency = 10
+)
+
+// AppDescriber retrieves information about an application.
+type AppDescriber struct {
+	app           string
+	region        string // Home region of the application, empty if unknown. Unused if homeRegion is set.
+	sessionRegion string // Region of the describer's session, empty if unknown.
+
+	configStore AppConfigStore
+	deployStore DeployedServicesLister
+	pipelineSvc pipelinesGetter
+	cfn         cfn
+	stackSetSvc stackSetDescriber
+	newWebSvc   func(svc string) (webSvcURIDescriber, error)          // Nil if service URLs can't be resolved.
+	newEnvCFN   func(env *config.Environment) (stackDescriber, error) // Nil if the stacks in environment accounts can't be read.
+	costSvc     costEstimator                                         // Nil if costs can't be estimated.
+	driftSvc    driftDetector                                         // Nil if the drift of the app stack can't be detected.
+	s3Svc       objectUploader                                        // Nil if the description can't be exported to S3.
+	logger      Logger                                                // Nil to not trace the API calls.
+	homeRegion  *homeRegionClients                                    // Nil if the clients of the home region are injected.
+
+	includeStackARNs      bool
+	includeServiceURLs    bool
+	includeRollouts       bool
+	includeEnvTags        bool
+	includeEnvStatus      bool
+	sortEnvsByAge         bool
+	enrichers             []func(*App) error
+	includeDrift          bool
+	includeConsoleURLs    bool
+	includeCoverage       bool
+	includeEnvRoles       bool
+	includeEnvEndpoints   bool
+	includeNetworking     bool
+	includeLastDeployed   bool
+	checkTags             bool
+	dryRun                bool
+	svcConcurrency        int
+	includeCost           bool
+	includePipelineStages bool
+	includeEnvVersions    bool
+	envTagColumns         []string
+	headerTranslations    map[string]string
+	groupServicesByType   bool
+	svcDeployFilter       serviceDeploymentFilter
+	bestEffort            bool
+	failOnWarnings        bool
+	maxMetadataAttempts   int               // Zero to use the retryer of the session.
+	versionComparator     VersionComparator // Nil to compare versions with semver.Compare.
+	stackNames            StackNameResolver // Nil to use the default stack names of Copilot.
+	now                   func() time.Time
+
+	mu       sync.Mutex
+	metadata map[string]string // Cached template Metadata keyed by stack or stack set name.
+
+	envCFNsMu sync.Mutex
+	envCFNs   map[string]stackDescriber // Cached clients of newEnvCFN keyed by environment name.
+}
+
+// AppDescriberOption is a functional option to configure an AppDescriber.
+type AppDescriberOption func(*AppDescriber)
+
+// WithMaxMetadataAttempts sets the maximum number of times the SDK retryer of the describer's CloudFormation client
+// sends a request, including the first one, when it's throttled or fails with a transient error.
+// It defaults to the retryer of the session, and has no effect on the client passed to NewAppDescriberFromStore.
+func WithMaxMetadataAttempts(attempts int) AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.maxMetadataAttempts = attempts
+	}
+}
+
+// VersionComparator compares two template versions. It returns a negative number if a is older than b,
+// zero if they are the same, and a positive number if a is newer than b.
+type VersionComparator func(a, b string) int
+
+// WithVersionComparator sets the function used by Version, VersionInfo and IsVersionAheadOf to order template versions.
+// It defaults to semver.Compare, which orders a pre-release such as "v1.2.0-preview" before its release "v1.2.0",
+// and pre-releases of the same version by comparing their identifiers.
+func WithVersionComparator(compare VersionComparator) AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.versionComparator = compare
+	}
+}
+
+// WithServiceConcurrency sets the maximum number of services whose details Describe retrieves at the same time with
+// the options that make API calls per service, such as WithServiceURLs, WithServiceRollouts and WithServiceLastDeployed.
+// It defaults to 10.
+func WithServiceConcurrency(n int) AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.svcConcurrency = n
+	}
+}
+
+// WithConfigStore sets the config store used to read the application, its environments and services.
+// If not set, the describer connects to the copilot config store with the default session the first time it reads it.
+func WithConfigStore(store AppConfigStore) AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.configStore = store
+	}
+}
+
+// WithDeployStore sets the deploy store used to list the services deployed in each environment.
+// If not set, the describer builds one on top of the copilot config store the first time it lists the deployed services.
+func WithDeployStore(store DeployedServicesLister) AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.deployStore = store
+	}
+}
+
+// WithServicesGroupedByType makes the RenderOptions of the describer group the services by type in human readable format.
+func WithServicesGroupedByType() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.groupServicesByType = true
+	}
+}
+
+// WithStackARNs makes Describe include the ARNs of the application's CloudFormation stack and stack set.
+func WithStackARNs() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeStackARNs = true
+	}
+}
+
+// WithConsoleURLs makes Describe include the links to the AWS console pages of the app stack, stack set and pipelines,
+// which are rendered in a Console section. Describe returns an error if the home region of the application is unknown.
+func WithConsoleURLs() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeConsoleURLs = true
+	}
+}
+
+// WithServiceURLs makes Describe resolve the URL of each Load Balanced Web Service in every environment it is deployed to.
+// It requires a deploy store, and it is slow as several API calls are made per service and environment.
+func WithServiceURLs() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeServiceURLs = true
+	}
+}
+
+// WithFailOnWarnings makes Describe return a *WarningsError listing the warnings of the description, if it has any,
+// instead of the description, so that strict CI pipelines fail on a misconfigured application. The warnings include
+// the errors tolerated by WithBestEffort and those of WithTagCheck. It doesn't apply to the synthetic warning of WithDryRun.
+func WithFailOnWarnings() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.failOnWarnings = true
+	}
+}
+
+// WithBestEffort makes Describe attach a warning to the description instead of returning an error
+// if it fails to list the services or the pipelines of the application, so that the parts that succeeded are still returned.
+func WithBestEffort() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.bestEffort = true
+	}
+}
+
+// WithServiceRollouts makes Describe read the rollout strategy of each service in every environment it is deployed to
+// from the deployment configuration of its stack, which is rendered as a Rollout column of the Services section.
+// It requires a deploy store, and it makes an extra CloudFormation call per service and environment.
+func WithServiceRollouts() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeRollouts = true
+	}
+}
+
+// WithEnvironmentTags makes Describe retrieve the tags of each environment stack, which are all serialized in JSON.
+// The tags with the given keys are also rendered as extra columns of the Environments section in human readable format
+// with the RenderOptions of the describer.
+// It makes an extra CloudFormation call per environment.
+func WithEnvironmentTags(columns ...string) AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeEnvTags = true
+		d.envTagColumns = columns
+	}
+}
+
+// WithEnvironmentStatus makes Describe derive the health of each environment from the status of its stack,
+// which is rendered as a Status column of the Environments section. It makes an extra CloudFormation call per environment,
+// unless WithEnvironmentTags is also set in which case both share the same call.
+func WithEnvironmentStatus() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeEnvStatus = true
+	}
+}
+
+// WithEnvironmentRoles makes Describe retrieve the ARNs of the manager and CloudFormation execution roles of each environment
+// from the outputs of its stack, which are rendered under each environment of the Environments section.
+// It makes an extra CloudFormation call per environment, unless another option already describes the environment stacks.
+func WithEnvironmentRoles() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeEnvRoles = true
+	}
+}
+
+// WithEnvironmentEndpoints makes Describe resolve the endpoint of each environment from the outputs of its stack:
+// its subdomain if the application has a domain, otherwise the DNS name of its public load balancer if it has one.
+// The endpoints are rendered as an Endpoint column of the Environments section. It makes an extra CloudFormation call
+// per environment, unless another option already describes the environment stacks.
+func WithEnvironmentEndpoints() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeEnvEndpoints = true
+	}
+}
+
+// WithEnvironmentsSortedByAge makes Describe retrieve the creation time of each environment stack, and makes the RenderOptions
+// of the describer sort the environments from the oldest to the newest instead of by name. It makes an extra CloudFormation call
+// per environment, unless WithEnvironmentTags or WithEnvironmentStatus is also set in which case they share the same call.
+func WithEnvironmentsSortedByAge() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.sortEnvsByAge = true
+	}
+}
+
+// WithEnrichers makes Describe run each enricher in order on the assembled description, for example to set custom
+// fields in its Extra map. Describe returns the error of the first enricher that fails.
+func WithEnrichers(enrichers ...func(*App) error) AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.enrichers = append(d.enrichers, enrichers...)
+	}
+}
+
+// WithDriftDetection makes Describe detect whether the resources of the app stack drifted from its template,
+// which is rendered as a Drift field of the About section. Drift detection is asynchronous and usually takes
+// from a few seconds up to minutes for the app stack, so Describe is significantly slower with this option.
+func WithDriftDetection() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeDrift = true
+	}
+}
+
+// WithServiceCoverage makes Describe list the environments that each service is deployed to, and makes the RenderOptions
+// of the describer render a Coverage column, such as "2/3", in the Services section so that services missing from some
+// environments stand out. It requires a deploy store.
+func WithServiceCoverage() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeCoverage = true
+	}
+}
+
+// WithCostEstimate makes Describe estimate the monthly cost of each environment from the costs of its resources
+// over the last 30 days in Cost Explorer, which is rendered as an EstCost column of the Environments section.
+// The estimate is approximate as it relies on the cost allocation tags of the resources.
+func WithCostEstimate() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeCost = true
+	}
+}
+
+// WithPipelineStages makes Describe keep the actions of each stage of the pipelines, such as their source, build and
+// deploy targets, which are rendered as an indented list of stages under each pipeline of the Pipelines section.
+// Pipelines only list their stages without their actions otherwise.
+func WithPipelineStages() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includePipelineStages = true
+	}
+}
+
+// serviceDeploymentFilter selects the services listed by Describe based on whether they are deployed.
+type serviceDeploymentFilter int
+
+const (
+	allServices serviceDeploymentFilter = iota
+	deployedServices
+	undeployedServices
+)
+
+// WithOnlyDeployedServices makes Describe list only the services that are deployed to at least one environment,
+// leaving out services that exist in the config store but were never deployed. It requires a deploy store.
+func WithOnlyDeployedServices() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.svcDeployFilter = deployedServices
+	}
+}
+
+// WithOnlyUndeployedServices makes Describe list only the services that exist in the config store
+// but aren't deployed to any environment, for example to find services to clean up. It requires a deploy store.
+func WithOnlyUndeployedServices() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.svcDeployFilter = undeployedServices
+	}
+}
+
+// NewAppDescriber instantiates an application describer.
+func NewAppDescriber(appName string, opts ...AppDescriberOption) (*AppDescriber, error) {
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("assume default role for app %s: %w", appName, err)
+	}
+	return NewAppDescriberWithSession(appName, sess, opts...)
+}
+
+// NewAppDescriberWithSession instantiates an application describer that makes
+// API calls with the given session instead of the default one.
+// The app stack and stack set are read in the home region recorded for the application in the config store,
+// or in the region of sess if none is recorded. The home region is resolved on the first call that needs it,
+// so the describer can be created without a region.
+func NewAppDescriberWithSession(appName string, sess *session.Session, opts ...AppDescriberOption) (*AppDescriber, error) {
+	d := &AppDescriber{
+		app:           appName,
+		sessionRegion: aws.StringValue(sess.Config.Region),
+
+		pipelineSvc: codepipeline.New(sess),
+		costSvc:     costexplorer.New(sess),
+		s3Svc:       s3.New(sess),
+
+		now: time.Now,
+	}
+	d.newEnvCFN = func(env *config.Environment) (stackDescriber, error) {
+		envSess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
+		}
+		return cloudformation.New(envSess), nil
+	}
+	d.homeRegion = &homeRegionClients{
+		app:  appName,
+		sess: sess,
+		appRegion: func() (string, error) {
+			app, err := d.configStore.GetApplication(d.app)
+			if err != nil {
+				return "", fmt.Errorf("get application %s: %w", d.app, err)
+			}
+			return app.Region, nil
+		},
+	}
+	d.cfn = homeRegionCFN{d.homeRegion}
+	d.stackSetSvc = homeRegionStackSet{d.homeRegion}
+	d.driftSvc = homeRegionCFN{d.homeRegion}
+	d.newWebSvc = func(svc string) (webSvcURIDescriber, error) {
+		store, ok := d.configStore.(ConfigStoreSvc)
+		if !ok {
+			return nil, fmt.Errorf("config store of application %s can't read environments", d.app)
+		}
+		return NewWebServiceDescriber(NewWebServiceConfig{
+			NewServiceConfig: NewServiceConfig{
+				App:         d.app,
+				Svc:         svc,
+				ConfigStore: store,
+			},
+		})
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	d.homeRegion.maxAttempts = d.maxMetadataAttempts
+	stores := &defaultStores{}
+	if d.configStore == nil {
+		d.configStore = defaultConfigStore{stores}
+	}
+	if d.deployStore == nil {
+		d.deployStore = defaultDeployStore{stores}
+	}
+	return d, nil
+}
+
+// NewAppDescriberReadOnly instantiates an application describer that makes all of its API calls, including the ones
+// against the config store and the environment stacks, by assuming roleARN in the region of the default session
+// instead of using the default role and the environment manager roles.
+//
+// The role needs read access only: "ssm:GetParameter" and "ssm:GetParametersByPath" on the copilot parameters,
+// "cloudformation:DescribeStacks", "cloudformation:GetTemplate", "cloudformation:GetTemplateSummary",
+// "cloudformation:DescribeStackSet" and "cloudformation:ListStackInstances", "codepipeline:ListPipelines",
+// "codepipeline:GetPipeline" and "codepipeline:GetPipelineState", "tag:GetResources", "sts:GetCallerIdentity",
+// "ce:GetCostAndUsage" with WithCostEstimate, and "cloudformation:DetectStackDrift",
+// "cloudformation:DescribeStackDriftDetectionStatus" and the read permissions of the app stack resources with WithDriftDetection.
+// Environments in other accounts than the role's can't be described.
+// The URLs of WithServiceURLs are still resolved with the environment manager roles.
+func NewAppDescriberReadOnly(appName, roleARN string, opts ...AppDescriberOption) (*AppDescriber, error) {
+	defaultSess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("assume default role for app %s: %w", appName, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(roleARN, aws.StringValue(defaultSess.Config.Region))
+	if err != nil {
+		return nil, fmt.Errorf("assume read-only role %s for app %s: %w", roleARN, appName, err)
+	}
+	store := config.NewStoreFromSession(sess)
+	deployStore, err := deploy.NewStoreFromRole(store, roleARN)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
+	opts = append([]AppDescriberOption{WithConfigStore(store), WithDeployStore(deployStore)}, opts...)
+	d, err := NewAppDescriberWithSession(appName, sess, opts...)
+	if err != nil {
+		return nil, err
+	}
+	d.newEnvCFN = func(env *config.Environment) (stackDescriber, error) {
+		envSess, err := sessions.NewProvider().FromRole(roleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("assume read-only role %s for environment %s: %w", roleARN, env.Name, err)
+		}
+		return cloudformation.New(envSess), nil
+	}
+	return d, nil
+}
+
+// NewAppDescriberWithRoleChain instantiates an application describer for an application that is only reachable by
+// assuming a chain of roles, for example from the management account of an organization to a member account.
+// The roles are assumed in order from the default session in the region of the default session, and all API calls
+// are made with the last role of the chain. The environment manager roles are assumed from the last role as well.
+// The returned error names the role of the chain that couldn't be assumed.
+func NewAppDescriberWithRoleChain(appName string, roleARNs []string, opts ...AppDescriberOption) (*AppDescriber, error) {
+	defaultSess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("assume default role for app %s: %w", appName, err)
+	}
+	sess, err := sessions.NewProvider().FromRoleChain(roleARNs, aws.StringValue(defaultSess.Config.Region))
+	if err != nil {
+		return nil, fmt.Errorf("reach app %s: %w", appName, err)
+	}
+	store := config.NewStoreFromSession(sess)
+	deployStore, err := deploy.NewStoreFromRoleChain(store, roleARNs)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
+	opts = append([]AppDescriberOption{WithConfigStore(store), WithDeployStore(deployStore)}, opts...)
+	d, err := NewAppDescriberWithSession(appName, sess, opts...)
+	if err != nil {
+		return nil, err
+	}
+	d.newEnvCFN = func(env *config.Environment) (stackDescriber, error) {
+		chain := append(append([]string{}, roleARNs...), env.ManagerRoleARN)
+		envSess, err := sessions.NewProvider().FromRoleChain(chain, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
+		}
+		return cloudformation.New(envSess), nil
+	}
+	return d, nil
 }
 
-// JSONString returns the stringified App struct with json format.
-func (a *App) JSONString() (string, error) {
-	b, err := json.Marshal(a)
+// homeRegionCFNSession returns a copy of sess whose clients call the CloudFormation endpoint of region, the application's home region.
+// The endpoint is resolved explicitly within the partition of the region, so that GovCloud and China regions
+// use their own domains and regions that require an opt-in and are more recent than the SDK are supported.
+// If sess already has a custom endpoint, then the endpoint is kept.
+func homeRegionCFNSession(appName, region string, sess *session.Session) (*session.Session, error) {
+	if aws.StringValue(sess.Config.Endpoint) != "" {
+		return sess.Copy(&aws.Config{Region: aws.String(region)}), nil
+	}
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		return nil, fmt.Errorf("resolve partition of region %s for application %s: region is not part of a known AWS partition", region, appName)
+	}
+	endpoint, err := partition.EndpointFor(awscfn.EndpointsID, region)
 	if err != nil {
-		return "", fmt.Errorf("marshal application description: %w", err)
+		return nil, fmt.Errorf("resolve CloudFormation endpoint in region %s for application %s: %w", region, appName, err)
 	}
-	return fmt.Sprintf("%s\n", b), nil
+	return sess.Copy(&aws.Config{Region: aws.String(region), Endpoint: aws.String(endpoint.URL)}), nil
 }
 
-// HumanString returns the stringified App struct with human readable format.
-func (a *App) HumanString() string {
-	var b bytes.Buffer
-	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
-	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
-	writer.Flush()
-	fmt.Fprintf(writer, "  %s\t%s\n", "Name", a.Name)
-	fmt.Fprintf(writer, "  %s\t%s\n", "URI", a.URI)
-	fmt.Fprint(writer, color.Bold.Sprint("\nEnvironments\n\n"))
-	writer.Flush()
-	headers := []string{"Name", "AccountID", "Region"}
-	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
-	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
-	for _, env := range a.Envs {
-		fmt.Fprintf(writer, "  %s\t%s\t%s\n", env.Name, env.AccountID, env.Region)
+// NewAppDescriberFromStore instantiates an application describer on top of already built dependencies,
+// for example a snapshot of the config store, so that no AWS session is created.
+//
+// Describe reads the application, its environments and services from store only. The services deployed
+// in each environment are listed only if a deploy store is set with WithDeployStore, and pipelines are not listed.
+// Version, VersionInfo, VersionAt and the stack ARNs of WithStackARNs are still read from CloudFormation through cfn.
+func NewAppDescriberFromStore(appName string, store AppConfigStore, cfn cfn, opts ...AppDescriberOption) *AppDescriber {
+	d := &AppDescriber{
+		app: appName,
+
+		configStore: store,
+		cfn:         cfn,
+
+		now: time.Now,
 	}
-	fmt.Fprint(writer, color.Bold.Sprint("\nServices\n\n"))
-	writer.Flush()
-	headers = []string{"Name", "Type"}
-	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
-	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
-	for _, svc := range a.Services {
-		fmt.Fprintf(writer, "  %s\t%s\n", svc.Name, svc.Type)
+	if detector, ok := cfn.(driftDetector); ok {
+		d.driftSvc = detector
 	}
-	fmt.Fprint(writer, color.Bold.Sprint("\nPipelines\n\n"))
-	writer.Flush()
-	headers = []string{"Name"}
-	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
-	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
-	for _, pipeline := range a.Pipelines {
-		fmt.Fprintf(writer, "  %s\n", pipeline.Name)
+	for _, opt := range opts {
+		opt(d)
 	}
-	writer.Flush()
-	return b.String()
+	return d
 }
 
-// AppDescriber retrieves information about an application.
-type AppDescriber struct {
-	app string
-	cfn cfn
+// Describe returns the description of the application, assembled from the config store,
+// the services deployed in each of its environments, and its pipelines.
+// If the application's home region differs from the region of the describer's session, then Describe returns an error
+// suggesting the region to use before calling any other API.
+// With WithDryRun, Describe only reads the config store and makes no other API call.
+func (d *AppDescriber) Describe() (*App, error) {
+	if d.dryRun {
+		return d.describeDryRun()
+	}
+	app, err := d.configStore.GetApplication(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", d.app, err)
+	}
+	if err := d.checkHomeRegion(app); err != nil {
+		return nil, err
+	}
+	envs, err := d.configStore.ListEnvironments(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", d.app, err)
+	}
+	var warnings []string
+	svcs, err := d.configStore.ListServices(d.app)
+	if err != nil {
+		err = fmt.Errorf("list services in application %s: %w", d.app, err)
+		if !d.bestEffort {
+			return nil, err
+		}
+		warnings = append(warnings, err.Error())
+	}
+	pipelines, statusWarnings, err := d.pipelines()
+	if err != nil {
+		if !d.bestEffort {
+			return nil, err
+		}
+		warnings = append(warnings, err.Error())
+	}
+	warnings = append(warnings, statusWarnings...)
+
+	// The environments are still listed if the app stack set can't be read, only whether they are managed is unknown.
+	managed, err := d.managedAccountRegions()
+	if err != nil {
+		warnings = append(warnings, err.Error())
+		managed = make(map[string]bool)
+	}
+
+	var costs map[string]*EstimatedCost
+	if d.includeCost {
+		costs, err = d.envCosts()
+		if err != nil {
+			return nil, err
+		}
+	}
+	var trimmedEnvs []*EnvSummary
+	var deployments map[string][]string
+	if d.deployStore != nil {
+		deployments = make(map[string][]string)
+	}
+	for _, env := range envs {
+		summary, err := d.envSummary(env, managed)
+		if err != nil {
+			return nil, err
+		}
+		if costs != nil {
+			summary.EstimatedMonthlyCost = costs[env.Name]
+			if summary.EstimatedMonthlyCost == nil {
+				summary.EstimatedMonthlyCost = &EstimatedCost{Unit: defaultCostUnit}
+			}
+		}
+		trimmedEnvs = append(trimmedEnvs, summary)
+		if d.deployStore == nil {
+			continue
+		}
+		deployedSvcs, err := d.deployStore.ListDeployedServices(d.app, env.Name)
+		if err != nil {
+			err = fmt.Errorf("list deployed services in environment %s: %w", env.Name, err)
+			if d.needsDeployments() {
+				return nil, err
+			}
+			warnings = append(warnings, err.Error())
+			continue
+		}
+		deployments[env.Name] = deployedSvcs
+	}
+	if d.includeNetworking {
+		markSharedVPCs(trimmedEnvs)
+	}
+	if d.svcDeployFilter != allServices && deployments == nil {
+		return nil, fmt.Errorf("filter services of application %s by deployment status: the deployed services are unknown without a deploy store", d.app)
+	}
+	if d.includeCoverage && deployments == nil {
+		return nil, fmt.Errorf("list environment coverage of services in application %s: the deployed services are unknown without a deploy store", d.app)
+	}
+	var trimmedSvcs []*ServiceSummary
+	for _, svc := range svcs {
+		if !d.svcDeployFilter.keep(svc.Name, deployments) {
+			continue
+		}
+		trimmedSvcs = append(trimmedSvcs, &ServiceSummary{
+			Workload: &config.Workload{
+				Name: svc.Name,
+				Type: svc.Type,
+			},
+		})
+	}
+	if err := d.enrichServices(trimmedSvcs, envs, deployments); err != nil {
+		return nil, err
+	}
+	description := &App{
+		Name:        app.Name,
+		URI:         app.Domain,
+		Envs:        trimmedEnvs,
+		Services:    trimmedSvcs,
+		Deployments: deployments,
+		Pipelines:   pipelines,
+		Warnings:    warnings,
+	}
+	appStackID, err := d.addAppStackInfo(description)
+	if err != nil {
+		if d.includeStackARNs || d.checkTags || d.includeConsoleURLs {
+			return nil, err
+		}
+		description.Warnings = append(description.Warnings, err.Error())
+	}
+	if d.includeConsoleURLs {
+		if description.Console, err = d.consoleURLs(appStackID, pipelines); err != nil {
+			return nil, err
+		}
+	}
+	if d.includeDrift {
+		if description.DriftStatus, err = d.DriftStatus(); err != nil {
+			return nil, err
+		}
+	}
+	description.Normalize()
+	for i, enrich := range d.enrichers {
+		if err := enrich(description); err != nil {
+			return nil, fmt.Errorf("run enricher %d on application %s: %w", i+1, d.app, err)
+		}
+	}
+	if d.failOnWarnings && len(description.Warnings) > 0 {
+		return nil, &WarningsError{App: d.app, Warnings: description.Warnings}
+	}
+	return description, nil
 }
 
-// NewAppDescriber instantiates an application describer.
-func NewAppDescriber(appName string) (*AppDescriber, error) {
-	sess, err := sessions.NewProvider().Default()
+// needsDeployments returns true if an option of the describer can't be applied without the services deployed in every environment.
+func (d *AppDescriber) needsDeployments() bool {
+	return d.svcDeployFilter != allServices || d.includeCoverage
+}
+
+// enrichServices sets the details of each service requested by the options of the describer. The services are enriched
+// concurrently, up to the concurrency of WithServiceConcurrency at a time, and each one only sets its own summary
+// so that the results don't depend on the order the calls complete in. It returns the error of the first service that fails.
+func (d *AppDescriber) enrichServices(summaries []*ServiceSummary, envs []*config.Environment, deployments map[string][]string) error {
+	limit := d.svcConcurrency
+	if limit < 1 {
+		limit = defaultServiceConcurrency
+	}
+	sem := make(chan struct{}, limit)
+	var g errgroup.Group
+	for _, summary := range summaries {
+		summary := summary
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			return d.enrichService(summary, envs, deployments)
+		})
+	}
+	return g.Wait()
+}
+
+func (d *AppDescriber) enrichService(summary *ServiceSummary, envs []*config.Environment, deployments map[string][]string) error {
+	var err error
+	if d.includeServiceURLs && summary.Type == manifest.LoadBalancedWebServiceType {
+		if summary.URLs, err = d.serviceURLs(summary.Name, deployments); err != nil {
+			return err
+		}
+	}
+	if d.includeCoverage {
+		summary.DeployedEnvs = deployedEnvs(summary.Name, deployments)
+	}
+	if d.includeRollouts {
+		if summary.Rollouts, err = d.serviceRollouts(summary.Name, envs, deployments); err != nil {
+			return err
+		}
+	}
+	if d.includeLastDeployed {
+		if summary.LastDeployed, err = d.serviceLastDeployed(summary.Name, envs, deployments); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// envSummary returns the description of an environment, along with the details of its stack requested by the options
+// of the describer. managed is the set of account and region pairs that have an instance of the app stack set.
+func (d *AppDescriber) envSummary(env *config.Environment, managed map[string]bool) (*EnvSummary, error) {
+	summary := &EnvSummary{
+		Environment: &config.Environment{
+			Name:      env.Name,
+			AccountID: env.AccountID,
+			Region:    env.Region,
+			Prod:      env.Prod,
+		},
+		Managed: managed[accountRegion(env.AccountID, env.Region)],
+	}
+	if d.includeEnvTags || d.includeEnvStatus || d.sortEnvsByAge || d.includeEnvRoles || d.includeEnvEndpoints || d.includeNetworking {
+		envStack, err := d.envStack(env)
+		if err != nil {
+			return nil, err
+		}
+		if envStack != nil && d.includeEnvTags {
+			summary.Tags = stackTags(envStack)
+		}
+		if envStack != nil && d.includeEnvStatus {
+			summary.Status = envHealth(aws.StringValue(envStack.StackStatus))
+		}
+		if envStack != nil && d.sortEnvsByAge {
+			summary.CreationTime = envStack.CreationTime
+		}
+		if envStack != nil && d.includeEnvRoles {
+			outputs := stackOutputs(envStack)
+			summary.ManagerRoleARN = outputs[stack.EnvOutputManagerRoleKey]
+			summary.ExecutionRoleARN = outputs[stack.EnvOutputCFNExecutionRoleARN]
+		}
+		if envStack != nil && d.includeEnvEndpoints {
+			summary.Endpoint = envEndpoint(stackOutputs(envStack))
+		}
+		if d.includeNetworking {
+			summary.VPC = envVPC(env, envStack)
+		}
+	}
+	if d.includeEnvVersions && d.newEnvCFN != nil {
+		version, err := d.envVersion(env)
+		if err != nil {
+			return nil, err
+		}
+		summary.TemplateVersion = version
+	}
+	return summary, nil
+}
+
+// envStack returns the description of the environment's CloudFormation stack.
+// It returns nil if the describer can't reach the environment's account.
+func (d *AppDescriber) envStack(env *config.Environment) (*cloudformation.StackDescription, error) {
+	if d.newEnvCFN == nil {
+		return nil, nil
+	}
+	client, err := d.envCFN(env)
 	if err != nil {
-		return nil, fmt.Errorf("assume default role for app %s: %w", appName, err)
+		return nil, fmt.Errorf("new CloudFormation client for environment %s: %w", env.Name, err)
 	}
-	return &AppDescriber{
-		app: appName,
-		cfn: cloudformation.New(sess),
+	envStackName := d.envStackName(env.Name)
+	done := d.traceCall("cloudformation.Describe", envStackName)
+	envStack, err := client.Describe(envStackName)
+	done()
+	if err != nil {
+		return nil, fmt.Errorf("describe stack %s of environment %s: %w", envStackName, env.Name, err)
+	}
+	return envStack, nil
+}
+
+// envCFN returns the CloudFormation client of the environment's account and region.
+// The client is created with newEnvCFN the first time it's requested for an environment and reused afterwards,
+// so that the environment's role is assumed once per describer rather than once per call.
+func (d *AppDescriber) envCFN(env *config.Environment) (stackDescriber, error) {
+	d.envCFNsMu.Lock()
+	defer d.envCFNsMu.Unlock()
+	if client, ok := d.envCFNs[env.Name]; ok {
+		return client, nil
+	}
+	client, err := d.newEnvCFN(env)
+	if err != nil {
+		return nil, err
+	}
+	if d.envCFNs == nil {
+		d.envCFNs = make(map[string]stackDescriber)
+	}
+	d.envCFNs[env.Name] = client
+	return client, nil
+}
+
+func stackOutputs(desc *cloudformation.StackDescription) map[string]string {
+	outputs := make(map[string]string)
+	for _, out := range desc.Outputs {
+		outputs[aws.StringValue(out.OutputKey)] = aws.StringValue(out.OutputValue)
+	}
+	return outputs
+}
+
+// envEndpoint returns the subdomain of an environment if it has one, otherwise the DNS name of its public load balancer.
+// It returns an empty string if the environment has neither.
+func envEndpoint(outputs map[string]string) string {
+	if subdomain := outputs[envOutputSubdomain]; subdomain != "" {
+		return subdomain
+	}
+	return outputs[envOutputPublicLoadBalancerDNSName]
+}
+
+func stackTags(desc *cloudformation.StackDescription) map[string]string {
+	tags := make(map[string]string)
+	for _, tag := range desc.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tags
+}
+
+// Health of an environment derived from the status of its CloudFormation stack.
+const (
+	EnvHealthy  = "healthy"
+	EnvUpdating = "updating"
+	EnvDegraded = "degraded"
+	EnvUnknown  = "unknown"
+)
+
+// envHealth maps the status of an environment stack to its health. A stack that rolled back
+// is degraded even though the rollback completed, as the last requested change wasn't applied.
+func envHealth(stackStatus string) string {
+	switch {
+	case strings.HasSuffix(stackStatus, "_IN_PROGRESS"):
+		return EnvUpdating
+	case strings.HasSuffix(stackStatus, "_FAILED"), strings.Contains(stackStatus, "ROLLBACK"):
+		return EnvDegraded
+	case strings.HasSuffix(stackStatus, "_COMPLETE"):
+		return EnvHealthy
+	default:
+		return EnvUnknown
+	}
+}
+
+// keep returns true if the service passes the filter given the services deployed in each environment.
+func (f serviceDeploymentFilter) keep(svc string, deployments map[string][]string) bool {
+	if f == allServices {
+		return true
+	}
+	deployed := false
+	for _, svcs := range deployments {
+		if containsString(svcs, svc) {
+			deployed = true
+			break
+		}
+	}
+	return deployed == (f == deployedServices)
+}
+
+// serviceURLs returns the URL of a web service in each environment that it is deployed to.
+// It returns a nil map if the services deployed in each environment are unknown.
+func (d *AppDescriber) serviceURLs(svc string, deployments map[string][]string) (map[string]string, error) {
+	if d.newWebSvc == nil || deployments == nil {
+		return nil, nil
+	}
+	var describer webSvcURIDescriber
+	var urls map[string]string
+	for env, deployed := range deployments {
+		if !containsString(deployed, svc) {
+			continue
+		}
+		if describer == nil {
+			var err error
+			describer, err = d.newWebSvc(svc)
+			if err != nil {
+				return nil, fmt.Errorf("new describer for service %s: %w", svc, err)
+			}
+			urls = make(map[string]string)
+		}
+		url, err := describer.URI(env)
+		if err != nil {
+			return nil, fmt.Errorf("resolve URL of service %s in environment %s: %w", svc, env, err)
+		}
+		urls[env] = url
+	}
+	return urls, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// PipelinesOnly returns a description of the application that contains only its name and its pipelines.
+// Unlike Describe, it doesn't read the application, its environments and services from the config store,
+// nor does it describe the app CloudFormation stack, so it only makes the API calls needed to list the pipelines
+// and the status of their latest execution. Render it with HumanStringSections(SectionPipelines).
+// If the describer has no pipeline client, for example when built with NewAppDescriberFromStore, then no pipeline is listed.
+func (d *AppDescriber) PipelinesOnly() (*App, error) {
+	pipelines, warnings, err := d.pipelines()
+	if err != nil {
+		return nil, err
+	}
+	return &App{
+		Name:      d.app,
+		Pipelines: pipelines,
+		Warnings:  warnings,
 	}, nil
 }
 
+// DescribeEnv returns the description of a single environment of the application, with the details of its stack
+// requested by the options of the describer such as WithEnvironmentStatus or WithEnvironmentRoles.
+// Unlike Describe, it doesn't list the services, deployments and pipelines of the application.
+// It returns an error if the environment isn't part of the application.
+func (d *AppDescriber) DescribeEnv(envName string) (*EnvSummary, error) {
+	envs, err := d.configStore.ListEnvironments(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", d.app, err)
+	}
+	var env *config.Environment
+	for _, e := range envs {
+		if e.Name == envName {
+			env = e
+			break
+		}
+	}
+	if env == nil {
+		return nil, fmt.Errorf("environment %s is not part of application %s", envName, d.app)
+	}
+	managed, err := d.managedAccountRegions()
+	if err != nil {
+		return nil, err
+	}
+	return d.envSummary(env, managed)
+}
+
+// pipelines returns the pipelines of the application along with the status of their latest execution.
+// If the status of a pipeline can't be retrieved, then it is left empty and the reason is returned as a warning.
+// If the describer has no pipeline client, then it returns no pipelines.
+func (d *AppDescriber) pipelines() ([]*PipelineSummary, []string, error) {
+	if d.pipelineSvc == nil {
+		return nil, nil, nil
+	}
+	done := d.traceCall("codepipeline.GetPipelinesByTags", d.app)
+	pipelines, err := d.pipelineSvc.GetPipelinesByTags(map[string]string{
+		deploy.AppTagKey: d.app,
+	})
+	done()
+	if err != nil {
+		return nil, nil, fmt.Errorf("list pipelines in application %s: %w", d.app, err)
+	}
+	var summaries []*PipelineSummary
+	var warnings []string
+	for _, pipeline := range pipelines {
+		done := d.traceCall("codepipeline.LatestExecutionStatus", pipeline.Name)
+		status, err := d.pipelineSvc.LatestExecutionStatus(pipeline.Name)
+		done()
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("get latest execution status of pipeline %s: %v", pipeline.Name, err))
+		}
+		summary := &PipelineSummary{
+			Pipeline: pipeline,
+			Status:   status,
+		}
+		if d.includePipelineStages {
+			summary.StageActions = pipelineStages(pipeline)
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, warnings, nil
+}
+
+// pipelineStages returns the actions of each stage of the pipeline.
+func pipelineStages(pipeline *codepipeline.Pipeline) []*PipelineStage {
+	var stages []*PipelineStage
+	for _, stage := range pipeline.Stages {
+		actions := []*PipelineAction{}
+		for _, action := range stage.Actions {
+			actions = append(actions, &PipelineAction{
+				Name:     action.Name,
+				Category: action.Category,
+				Provider: action.Provider,
+				Details:  action.Details,
+			})
+		}
+		stages = append(stages, &PipelineStage{
+			Name:    stage.Name,
+			Actions: actions,
+		})
+	}
+	return stages
+}
+
+// managedAccountRegions returns the set of account and region pairs that have an instance of the app stack set.
+// If the describer can't read the stack set, then it returns an empty set.
+func (d *AppDescriber) managedAccountRegions() (map[string]bool, error) {
+	managed := make(map[string]bool)
+	if d.stackSetSvc == nil {
+		return managed, nil
+	}
+	appStackSetName := d.appStackSetName()
+	done := d.traceCall("stackset.InstanceSummaries", appStackSetName)
+	summaries, err := d.stackSetSvc.InstanceSummaries(appStackSetName)
+	done()
+	if err != nil {
+		return nil, fmt.Errorf("list instances of app stack set %s: %w", appStackSetName, err)
+	}
+	for _, summary := range summaries {
+		managed[accountRegion(summary.Account, summary.Region)] = true
+	}
+	return managed, nil
+}
+
+func accountRegion(account, region string) string {
+	return account + "/" + region
+}
+
+// addAppStackInfo sets the creation and last update times of the app stack on the description,
+// as well as the stack ARNs if they are requested. It returns the ID of the app stack.
+func (d *AppDescriber) addAppStackInfo(description *App) (string, error) {
+	appStack, err := d.describeAppStack()
+	if err != nil {
+		return "", err
+	}
+	stackID := aws.StringValue(appStack.StackId)
+	description.CreationTime = appStack.CreationTime
+	if d.checkTags {
+		description.Warnings = append(description.Warnings, d.appStackTagWarnings(stackTags(appStack))...)
+	}
+	description.LastUpdatedTime = appStack.LastUpdatedTime
+	if !d.includeStackARNs {
+		return stackID, nil
+	}
+	description.StackARN = stackID
+	if d.stackSetSvc == nil {
+		return stackID, nil
+	}
+	appStackSetName := d.appStackSetName()
+	done := d.traceCall("stackset.Describe", appStackSetName)
+	appStackSet, err := d.stackSetSvc.Describe(appStackSetName)
+	done()
+	if err != nil {
+		return stackID, fmt.Errorf("describe app stack set %s: %w", appStackSetName, err)
+	}
+	description.StackSetARN = appStackSet.ARN
+	return stackID, nil
+}
+
+func (d *AppDescriber) describeAppStack() (*cloudformation.StackDescription, error) {
+	appStackName := d.appStackName()
+	done := d.traceCall("cloudformation.Describe", appStackName)
+	appStack, err := d.cfn.Describe(appStackName)
+	done()
+	if err != nil {
+		var notFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &notFound) {
+			err = &errAppStackNotFound{err: err}
+		}
+		return nil, fmt.Errorf("describe app stack %s: %w", appStackName, d.regionErr(err))
+	}
+	return appStack, nil
+}
+
+// AppVersionInfo holds the CloudFormation template versions of an application's stack and stack set.
+type AppVersionInfo struct {
+	StackVersion     string `json:"stackVersion"`
+	StackSetVersion  string `json:"stackSetVersion,omitempty"` // Empty if the stack set doesn't exist yet.
+	StackSetNotFound bool   `json:"stackSetNotFound,omitempty"`
+	MinVersion       string `json:"minVersion"`
+	IsLegacy         bool   `json:"isLegacy"`
+	Warning          string `json:"warning,omitempty"` // Set if the stack and stack set versions diverge, which usually means that an upgrade was interrupted.
+}
+
 // Version returns the app CloudFormation template version associated with
 // the application by reading the Metadata.Version field from the template.
 // Specifically it will get both app CFN stack template version and app StackSet template version,
 // and return the minimum as the current app version.
 //
 // If the Version field does not exist, then it's a legacy template and it returns an deploy.LegacyAppTemplateVersion and nil error.
+// If the app stack does not exist, then the returned error matches ErrAppStackNotFound.
 func (d *AppDescriber) Version() (string, error) {
-	type metadata struct {
+	return d.VersionWithContext(context.Background())
+}
+
+// VersionWithContext is like Version but the CloudFormation requests are canceled once ctx is done,
+// in which case the returned error wraps the error of ctx.
+func (d *AppDescriber) VersionWithContext(ctx context.Context) (string, error) {
+	info, err := d.VersionInfoWithContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return info.MinVersion, nil
+}
+
+// VersionInfo returns the template versions of both the app CloudFormation stack and the app StackSet,
+// as well as the minimum of the two which is considered the current app version.
+//
+// A component without a Version field in its template falls back to deploy.LegacyAppTemplateVersion, in which case IsLegacy is set to true.
+// If the two versions differ, then Warning explains how to reconcile them.
+// If the stack set doesn't exist yet, then StackSetNotFound is set and the versions are the ones of the app stack only.
+func (d *AppDescriber) VersionInfo() (*AppVersionInfo, error) {
+	return d.VersionInfoWithContext(context.Background())
+}
+
+// VersionInfoWithContext is like VersionInfo but the CloudFormation requests are canceled once ctx is done.
+func (d *AppDescriber) VersionInfoWithContext(ctx context.Context) (*AppVersionInfo, error) {
+	if d.dryRun {
+		return nil, fmt.Errorf("get version of application %s: %w", d.app, ErrUnavailableInDryRun)
+	}
+	var appStackVersion, appStackSetVersion string
+	var stackSetNotFound bool
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		appStackMetadata, err := d.appStackMetadata(ctx)
+		if err != nil {
+			return err
+		}
+		appStackVersion = metadataTemplateVersion(appStackMetadata)
+		return nil
+	})
+	g.Go(func() error {
+		appStackSetName := d.appStackSetName()
+		appStackSetMetadata, err := d.stackSetMetadata(ctx, appStackSetName)
+		if err != nil {
+			// The stack set is created after the app stack while the application is bootstrapped.
+			var notFound *cloudformation.ErrStackSetNotFound
+			if errors.As(err, &notFound) {
+				stackSetNotFound = true
+				return nil
+			}
+			return &MetadataError{StackName: appStackSetName, IsStackSet: true, Err: err}
+		}
+		appStackSetVersion, err = appTemplateVersion(appStackSetMetadata)
+		if err != nil {
+			return fmt.Errorf("unmarshal Metadata property for app stack set %s: %w", appStackSetName, err)
+		}
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	if stackSetNotFound {
+		return &AppVersionInfo{
+			StackVersion:     appStackVersion,
+			StackSetNotFound: true,
+			MinVersion:       appStackVersion,
+			IsLegacy:         appStackVersion == deploy.LegacyAppTemplateVersion,
+		}, nil
+	}
+	info := &AppVersionInfo{
+		StackVersion:    appStackVersion,
+		StackSetVersion: appStackSetVersion,
+		MinVersion:      minAppTemplateVersion(appStackVersion, appStackSetVersion, d.versionComparator),
+		IsLegacy:        appStackVersion == deploy.LegacyAppTemplateVersion || appStackSetVersion == deploy.LegacyAppTemplateVersion,
+	}
+	if appStackVersion != appStackSetVersion {
+		info.Warning = fmt.Sprintf("app stack %s is on template version %s but app stack set %s is on template version %s, re-run the upgrade of application %s",
+			d.appStackName(), appStackVersion, d.appStackSetName(), appStackSetVersion, d.app)
+	}
+	return info, nil
+}
+
+// Metadata returns the decoded Metadata section of the app CloudFormation stack template,
+// so that callers can read keys other than the template version. The Metadata is cached by the describer
+// and shared with Version, so calling both results in a single CloudFormation request.
+//
+// If the template does not have a Metadata section, then it returns a nil map and nil error.
+// If the app stack does not exist, then the returned error matches ErrAppStackNotFound.
+func (d *AppDescriber) Metadata() (map[string]interface{}, error) {
+	return d.appStackMetadata(context.Background())
+}
+
+func (d *AppDescriber) appStackMetadata(ctx context.Context) (map[string]interface{}, error) {
+	appStackName := d.appStackName()
+	raw, err := d.stackMetadata(ctx, appStackName)
+	if err != nil {
+		var notFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &notFound) {
+			err = &errAppStackNotFound{err: err}
+		}
+		return nil, &MetadataError{StackName: appStackName, Err: d.regionErr(err)}
+	}
+	var metadata map[string]interface{}
+	if err := yaml.Unmarshal([]byte(raw), &metadata); err != nil {
+		return nil, fmt.Errorf("unmarshal Metadata property for app stack %s: %w", appStackName, err)
+	}
+	return metadata, nil
+}
+
+// IsVersionAheadOf returns true if the application was deployed with a template version newer than cliMax,
+// the latest app template version supported by the CLI. Legacy templates are never ahead.
+// This happens when a teammate upgraded the application with a newer version of the CLI.
+// cliMax must be a semantic version unless the describer has a custom VersionComparator, which defines its own format.
+func (d *AppDescriber) IsVersionAheadOf(cliMax string) (bool, error) {
+	if d.versionComparator == nil && !semver.IsValid(cliMax) {
+		return false, fmt.Errorf("version %s is not a valid semantic version", cliMax)
+	}
+	version, err := d.Version()
+	if err != nil {
+		return false, err
+	}
+	if version == deploy.LegacyAppTemplateVersion {
+		return false, nil
+	}
+	return d.compareVersions(version, cliMax) > 0, nil
+}
+
+// compareVersions compares two app template versions with the comparator of the describer.
+func (d *AppDescriber) compareVersions(a, b string) int {
+	if d.versionComparator == nil {
+		return semver.Compare(a, b)
+	}
+	return d.versionComparator(a, b)
+}
+
+// minAppTemplateVersion returns the older of two app template versions according to compare, or semver.Compare if nil.
+// The legacy version is not compared as a semantic version: if either version is legacy,
+// then the result is deploy.LegacyAppTemplateVersion as it is the most conservative answer.
+func minAppTemplateVersion(a, b string, compare VersionComparator) string {
+	if a == deploy.LegacyAppTemplateVersion || b == deploy.LegacyAppTemplateVersion {
+		return deploy.LegacyAppTemplateVersion
+	}
+	if compare == nil {
+		compare = semver.Compare
+	}
+	if compare(a, b) > 0 {
+		return b
+	}
+	return a
+}
+
+// VersionAt returns the template version of the app CloudFormation stack as of the given change set,
+// instead of the currently deployed template. This is useful to preview whether a pending change set
+// upgrades the app template before executing it.
+//
+// If the template of the change set does not have a Version field, then it returns deploy.LegacyAppTemplateVersion and nil error.
+func (d *AppDescriber) VersionAt(changeSetID string) (string, error) {
+	appStackName := d.appStackName()
+	done := d.traceCall("cloudformation.TemplateBodyFromChangeSet", changeSetID)
+	body, err := d.cfn.TemplateBodyFromChangeSet(changeSetID, appStackName)
+	done()
+	if err != nil {
+		return "", fmt.Errorf("get template of change set %s for app stack %s: %w", changeSetID, appStackName, err)
+	}
+	metadata, err := templateMetadata(body)
+	if err != nil {
+		return "", fmt.Errorf("unmarshal template of change set %s for app stack %s: %w", changeSetID, appStackName, err)
+	}
+	version, err := appTemplateVersion(metadata)
+	if err != nil {
+		return "", fmt.Errorf("unmarshal Metadata property of change set %s for app stack %s: %w", changeSetID, appStackName, err)
+	}
+	return version, nil
+}
+
+// Refresh discards the template metadata cached by the describer,
+// so that subsequent calls retrieve it from CloudFormation again.
+func (d *AppDescriber) Refresh() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.metadata = nil
+}
+
+func (d *AppDescriber) stackMetadata(ctx context.Context, name string) (string, error) {
+	return d.cachedMetadata(ctx, "stack/"+name, cloudformation.MetadataWithStackName(name))
+}
+
+func (d *AppDescriber) stackSetMetadata(ctx context.Context, name string) (string, error) {
+	return d.cachedMetadata(ctx, "stackset/"+name, cloudformation.MetadataWithStackSetName(name))
+}
+
+// cachedMetadata returns the Metadata stored under key if it has been retrieved before,
+// otherwise it calls CloudFormation and caches the result.
+func (d *AppDescriber) cachedMetadata(ctx context.Context, key string, opt cloudformation.MetadataOpts) (string, error) {
+	d.mu.Lock()
+	metadata, ok := d.metadata[key]
+	d.mu.Unlock()
+	if ok {
+		return metadata, nil
+	}
+	done := d.traceCall("cloudformation.Metadata", key)
+	metadata, err := d.cfn.MetadataWithContext(ctx, opt)
+	done()
+	if err != nil {
+		return "", err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.metadata == nil {
+		d.metadata = make(map[string]string)
+	}
+	d.metadata[key] = metadata
+	return metadata, nil
+}
+
+// checkHomeRegion returns an error if the application lives in a different region than the one of the describer's session,
+// as the services and environments of the application would be looked up in the wrong region. Applications without a recorded home region are not checked.
+func (d *AppDescriber) checkHomeRegion(app *config.Application) error {
+	if app.Region == "" || d.sessionRegion == "" || app.Region == d.sessionRegion {
+		return nil
+	}
+	return fmt.Errorf("application %s lives in region %s but the default session is in region %s: set the AWS_REGION environment variable or use a profile with region %s",
+		app.Name, app.Region, d.sessionRegion, app.Region)
+}
+
+// regionErr wraps err with errRegionDisabled if the home region of the application rejected the credentials of the request,
+// otherwise it returns err as is.
+func (d *AppDescriber) regionErr(err error) error {
+	region := d.homeRegionName()
+	if region == "" {
+		return err
+	}
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return err
+	}
+	switch aerr.Code() {
+	case "UnrecognizedClientException", "InvalidClientTokenId":
+		return &errRegionDisabled{region: region, err: err}
+	}
+	return err
+}
+
+// appTemplateVersion reads the TemplateVersion field from the Metadata of an app template.
+// If the field does not exist, then it returns deploy.LegacyAppTemplateVersion.
+func appTemplateVersion(rawMetadata string) (string, error) {
+	metadata := struct {
 		TemplateVersion string `yaml:"TemplateVersion"`
+	}{}
+	if err := yaml.Unmarshal([]byte(rawMetadata), &metadata); err != nil {
+		return "", err
+	}
+	if metadata.TemplateVersion == "" {
+		return deploy.LegacyAppTemplateVersion, nil
+	}
+	return metadata.TemplateVersion, nil
+}
+
+// metadataTemplateVersion reads the TemplateVersion field from the decoded Metadata of an app template.
+// If the field does not exist, then it returns deploy.LegacyAppTemplateVersion.
+func metadataTemplateVersion(metadata map[string]interface{}) string {
+	version, _ := metadata["TemplateVersion"].(string)
+	if version == "" {
+		return deploy.LegacyAppTemplateVersion
+	}
+	return version
+}
+
+// templateMetadata returns the raw Metadata section of a template body.
+// If the template does not have a Metadata section, then it returns an empty string.
+func templateMetadata(body string) (string, error) {
+	tpl := struct {
+		Metadata yaml.Node `yaml:"Metadata"`
+	}{}
+	if err := yaml.Unmarshal([]byte(body), &tpl); err != nil {
+		return "", err
 	}
-	stackMetadata, stackSetMetadata := metadata{}, metadata{}
+	if tpl.Metadata.IsZero() {
+		return "", nil
+	}
+	out, err := yaml.Marshal(&tpl.Metadata)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
 
-	appStackName := stack.NameForAppStack(d.app)
-	appStackMetadata, err := d.cfn.Metadata(cloudformation.MetadataWithStackName(appStackName))
+// marshalYAML returns the YAML encoding of v. The document is derived from v's JSON encoding
+// so that the keys are identical to the json struct tags.
+func marshalYAML(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
 	if err != nil {
-		return "", fmt.Errorf("get metadata for app stack %s: %w", appStackName, err)
+		return nil, err
 	}
-	if err := yaml.Unmarshal([]byte(appStackMetadata), &stackMetadata); err != nil {
-		return "", fmt.Errorf("unmarshal Metadata property for app stack %s: %w", appStackName, err)
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	resetYAMLStyle(&doc)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(yamlIndent)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// yamlIndent is the number of spaces that nested YAML blocks are indented with.
+const yamlIndent = 2
+
+// resetYAMLStyle clears the flow and quoting styles inherited from decoding JSON
+// so that the node is emitted in block style. It also clears anchors so that the output never has anchors nor aliases,
+// which some strict YAML parsers reject. The keys keep the order of the JSON encoding, where map keys are sorted.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	node.Anchor = ""
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
 	}
-	appStackVersion := stackMetadata.TemplateVersion
-	if appStackVersion == "" {
-		appStackVersion = deploy.LegacyAppTemplateVersion
+}
+
+// StackNames returns the names of the CloudFormation stacks and stack sets that the describer queries:
+// the app stack, the app stack set, then the stack of each of the given environments in order.
+// The names are resolved with the StackNameResolver of the describer, if any.
+// It doesn't make any API calls, so callers list the environments themselves, for example to scope an IAM policy.
+func (d *AppDescriber) StackNames(envs ...string) []string {
+	names := []string{d.appStackName(), d.appStackSetName()}
+	for _, env := range envs {
+		names = append(names, d.envStackName(env))
 	}
+	return names
+}
 
-	appStackSetName := stack.NameForAppStackSet(d.app)
-	appStackSetMetadata, err := d.cfn.Metadata(cloudformation.MetadataWithStackSetName(appStackSetName))
+// OrphanedServices returns the sorted names of the services of the application that are in the config store
+// but whose stack isn't deployed in any environment, according to the deploy store.
+func (d *AppDescriber) OrphanedServices() ([]string, error) {
+	if d.deployStore == nil {
+		return nil, fmt.Errorf("find orphaned services of application %s: the deployed services are unknown without a deploy store", d.app)
+	}
+	envs, err := d.configStore.ListEnvironments(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", d.app, err)
+	}
+	svcs, err := d.configStore.ListServices(d.app)
 	if err != nil {
-		return "", fmt.Errorf("get metadata for app stack set %s: %w", appStackSetName, err)
+		return nil, fmt.Errorf("list services in application %s: %w", d.app, err)
 	}
-	if err := yaml.Unmarshal([]byte(appStackSetMetadata), &stackSetMetadata); err != nil {
-		return "", fmt.Errorf("unmarshal Metadata property for app stack set %s: %w", appStackSetName, err)
+	deployments := make(map[string][]string)
+	for _, env := range envs {
+		deployed, err := d.deployStore.ListDeployedServices(d.app, env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list deployed services in environment %s: %w", env.Name, err)
+		}
+		deployments[env.Name] = deployed
 	}
-	appStackSetVersion := stackSetMetadata.TemplateVersion
-	if appStackSetVersion == "" {
-		appStackSetVersion = deploy.LegacyAppTemplateVersion
+	var orphans []string
+	for _, svc := range svcs {
+		if undeployedServices.keep(svc.Name, deployments) {
+			orphans = append(orphans, svc.Name)
+		}
 	}
+	sort.Strings(orphans)
+	return orphans, nil
+}
 
-	minVersion := appStackVersion
-	if semver.Compare(appStackVersion, appStackSetVersion) > 0 {
-		minVersion = appStackSetVersion
+// EnvsMissingService returns the sorted names of the environments of the application that the service isn't deployed to,
+// according to the deploy store, or an empty slice if it's deployed everywhere. It returns an error if the service
+// isn't part of the application.
+func (d *AppDescriber) EnvsMissingService(svc string) ([]string, error) {
+	if d.deployStore == nil {
+		return nil, fmt.Errorf("find environments missing service %s: the deployed services are unknown without a deploy store", svc)
+	}
+	svcs, err := d.configStore.ListServices(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list services in application %s: %w", d.app, err)
+	}
+	var exists bool
+	for _, s := range svcs {
+		if s.Name == svc {
+			exists = true
+			break
+		}
+	}
+	if !exists {
+		return nil, fmt.Errorf("service %s is not part of application %s", svc, d.app)
+	}
+	envs, err := d.configStore.ListEnvironments(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", d.app, err)
+	}
+	missing := []string{}
+	for _, env := range envs {
+		deployed, err := d.deployStore.ListDeployedServices(d.app, env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list deployed services in environment %s: %w", env.Name, err)
+		}
+		if !containsString(deployed, svc) {
+			missing = append(missing, env.Name)
+		}
 	}
-	return minVersion, nil
+	sort.Strings(missing)
+	return missing, nil
 }
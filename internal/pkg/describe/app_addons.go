@@ -0,0 +1,120 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+)
+
+// addonsStackLogicalID is the logical ID of the nested stack that holds the addons of a workload.
+const addonsStackLogicalID = "AddonsStack"
+
+// AddonsVersion returns the minimum template version of the addons stacks of the application, or NoAddonsVersion
+// if none of its deployed services has addons. Addons are deployed as nested stacks of the workload stacks,
+// so their version is read from the TemplateVersion field of the Metadata of each addons template, like the app components.
+// Addons templates without a TemplateVersion field are on deploy.LegacyAppTemplateVersion.
+//
+// It requires a deploy store and makes, for each environment, one role assumption, and for each service deployed in it,
+// one DescribeStackResources call on the service stack and one GetTemplateSummary call on its addons stack.
+func (d *AppDescriber) AddonsVersion() (string, error) {
+	versions, err := d.addonsVersions()
+	if err != nil {
+		return "", err
+	}
+	if len(versions) == 0 {
+		return NoAddonsVersion, nil
+	}
+	var min string
+	for _, version := range versions {
+		if min == "" {
+			min = version
+			continue
+		}
+		min = minAppTemplateVersion(min, version, d.versionComparator)
+	}
+	return min, nil
+}
+
+// addonsVersions returns the template version of the addons stack of each service deployed in the application,
+// keyed by the name of the addons stack.
+func (d *AppDescriber) addonsVersions() (map[string]string, error) {
+	if d.deployStore == nil || d.newEnvCFN == nil {
+		return nil, fmt.Errorf("get addons of application %s: the describer can't read the workload stacks of the environments", d.app)
+	}
+	envs, err := d.configStore.ListEnvironments(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", d.app, err)
+	}
+	versions := make(map[string]string)
+	for _, env := range envs {
+		svcs, err := d.deployStore.ListDeployedServices(d.app, env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list deployed services in environment %s: %w", env.Name, err)
+		}
+		if len(svcs) == 0 {
+			continue
+		}
+		client, err := d.envCFN(env)
+		if err != nil {
+			return nil, fmt.Errorf("new CloudFormation client for environment %s: %w", env.Name, err)
+		}
+		for _, svc := range svcs {
+			addonsStackID, err := d.addonsStackID(client, d.svcStackName(env.Name, svc))
+			if err != nil {
+				return nil, err
+			}
+			if addonsStackID == "" {
+				continue
+			}
+			done := d.traceCall("cloudformation.Metadata", addonsStackID)
+			raw, err := client.Metadata(cloudformation.MetadataWithStackName(addonsStackID))
+			done()
+			if err != nil {
+				return nil, fmt.Errorf("get metadata for addons stack %s: %w", addonsStackID, err)
+			}
+			version, err := appTemplateVersion(raw)
+			if err != nil {
+				return nil, fmt.Errorf("unmarshal Metadata property for addons stack %s: %w", addonsStackID, err)
+			}
+			versions[stackNameFromID(addonsStackID)] = version
+		}
+	}
+	return versions, nil
+}
+
+// addonsStackID returns the ID of the addons nested stack of the workload stack svcStackName, or an empty string
+// if the workload has no addons.
+func (d *AppDescriber) addonsStackID(client stackDescriber, svcStackName string) (string, error) {
+	done := d.traceCall("cloudformation.StackResources", svcStackName)
+	resources, err := client.StackResources(svcStackName)
+	done()
+	if err != nil {
+		return "", fmt.Errorf("list resources of stack %s: %w", svcStackName, err)
+	}
+	for _, resource := range resources {
+		if aws.StringValue(resource.LogicalResourceId) == addonsStackLogicalID {
+			return aws.StringValue(resource.PhysicalResourceId), nil
+		}
+	}
+	return "", nil
+}
+
+// stackNameFromID returns the name of the stack in the stack ID, an ARN of the form
+// "arn:aws:cloudformation:{region}:{account}:stack/{name}/{uuid}". The ID is returned as is if it can't be parsed.
+func stackNameFromID(stackID string) string {
+	parsed, err := arn.Parse(stackID)
+	if err != nil {
+		return stackID
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) < 2 {
+		return stackID
+	}
+	return parts[1]
+}
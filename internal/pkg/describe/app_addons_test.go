@@ -0,0 +1,172 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddonsStackID = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend-AddonsStack-1ABCDEFGHIJK/6d1c1b20-9a1f-11eb-8e2f-0a5a1e7d3b4f"
+
+// addonsStackMetadata returns the Metadata section of the addons template fixture, as returned by GetTemplateSummary.
+func addonsStackMetadata(t *testing.T) string {
+	body, err := ioutil.ReadFile(filepath.Join("testdata", "addons_stack.yml"))
+	require.NoError(t, err)
+	metadata, err := templateMetadata(string(body))
+	require.NoError(t, err)
+	return metadata
+}
+
+// frontendStackResources returns the resources of the frontend service stack, with its nested addons stack if withAddons is true.
+func frontendStackResources(withAddons bool) []*cloudformation.StackResource {
+	resources := []*cloudformation.StackResource{
+		{LogicalResourceId: aws.String("Service"), PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-frontend-Service"), ResourceType: aws.String("AWS::ECS::Service")},
+		{LogicalResourceId: aws.String("TaskDefinition"), PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:3"), ResourceType: aws.String("AWS::ECS::TaskDefinition")},
+	}
+	if withAddons {
+		resources = append(resources, &cloudformation.StackResource{
+			LogicalResourceId:  aws.String("AddonsStack"),
+			PhysicalResourceId: aws.String(testAddonsStackID),
+			ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+		})
+	}
+	return resources
+}
+
+func TestAppDescriber_AddonsVersion(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		withoutDeployStore bool
+		setupMocks         func(deployStore *mocks.MockDeployedServicesLister, envCFN *mocks.MockstackDescriber)
+
+		wanted      string
+		wantedError error
+	}{
+		"returns the version of the addons stack": {
+			setupMocks: func(deployStore *mocks.MockDeployedServicesLister, envCFN *mocks.MockstackDescriber) {
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend", "backend"}, nil)
+				envCFN.EXPECT().StackResources("phonetool-test-frontend").Return(frontendStackResources(true), nil)
+				envCFN.EXPECT().StackResources("phonetool-test-backend").Return(nil, nil)
+				envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName(testAddonsStackID)).Return(addonsStackMetadata(t), nil)
+			},
+
+			wanted: "v1.1.0",
+		},
+		"returns the legacy version if the addons template has no TemplateVersion": {
+			setupMocks: func(deployStore *mocks.MockDeployedServicesLister, envCFN *mocks.MockstackDescriber) {
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend"}, nil)
+				envCFN.EXPECT().StackResources("phonetool-test-frontend").Return(frontendStackResources(true), nil)
+				envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName(testAddonsStackID)).Return("'aws:copilot:description': 'An Addons CloudFormation Stack'", nil)
+			},
+
+			wanted: "v0.0.0",
+		},
+		"returns the sentinel if no deployed service has addons": {
+			setupMocks: func(deployStore *mocks.MockDeployedServicesLister, envCFN *mocks.MockstackDescriber) {
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend"}, nil)
+				envCFN.EXPECT().StackResources("phonetool-test-frontend").Return(frontendStackResources(false), nil)
+			},
+
+			wanted: NoAddonsVersion,
+		},
+		"returns the sentinel if no service is deployed": {
+			setupMocks: func(deployStore *mocks.MockDeployedServicesLister, envCFN *mocks.MockstackDescriber) {
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, nil)
+			},
+
+			wanted: NoAddonsVersion,
+		},
+		"returns a wrapped error if the metadata of the addons stack can't be read": {
+			setupMocks: func(deployStore *mocks.MockDeployedServicesLister, envCFN *mocks.MockstackDescriber) {
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend"}, nil)
+				envCFN.EXPECT().StackResources("phonetool-test-frontend").Return(frontendStackResources(true), nil)
+				envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName(testAddonsStackID)).Return("", testError)
+			},
+
+			wantedError: fmt.Errorf("get metadata for addons stack %s: %w", testAddonsStackID, testError),
+		},
+		"returns a wrapped error if the resources of a service stack can't be listed": {
+			setupMocks: func(deployStore *mocks.MockDeployedServicesLister, envCFN *mocks.MockstackDescriber) {
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend"}, nil)
+				envCFN.EXPECT().StackResources("phonetool-test-frontend").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list resources of stack phonetool-test-frontend: %w", testError),
+		},
+		"returns error if the describer has no deploy store": {
+			withoutDeployStore: true,
+
+			wantedError: errors.New("get addons of application phonetool: the describer can't read the workload stacks of the environments"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			deployStore := mocks.NewMockDeployedServicesLister(ctrl)
+			envCFN := mocks.NewMockstackDescriber(ctrl)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				deployStore: deployStore,
+				newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+					return envCFN, nil
+				},
+			}
+			if tc.withoutDeployStore {
+				d.deployStore = nil
+			} else {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test", Region: "us-west-2"}}, nil)
+				tc.setupMocks(deployStore, envCFN)
+			}
+
+			// WHEN
+			actual, err := d.AddonsVersion()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
+
+func TestStackNameFromID(t *testing.T) {
+	require.Equal(t, "phonetool-test-frontend-AddonsStack-1ABCDEFGHIJK", stackNameFromID(testAddonsStackID))
+	require.Equal(t, "phonetool-test-frontend", stackNameFromID("phonetool-test-frontend"))
+}
+
+// withFrontendAddons sets up d with a test environment where the frontend service is deployed,
+// with the addons stack of the fixture if withAddons is true.
+func withFrontendAddons(t *testing.T, ctrl *gomock.Controller, d *AppDescriber, withAddons bool) {
+	configStore := mocks.NewMockAppConfigStore(ctrl)
+	configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test", Region: "us-west-2"}}, nil).AnyTimes()
+	deployStore := mocks.NewMockDeployedServicesLister(ctrl)
+	deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend"}, nil).AnyTimes()
+	envCFN := mocks.NewMockstackDescriber(ctrl)
+	envCFN.EXPECT().StackResources("phonetool-test-frontend").Return(frontendStackResources(withAddons), nil).AnyTimes()
+	envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName(testAddonsStackID)).Return(addonsStackMetadata(t), nil).AnyTimes()
+	d.configStore = configStore
+	d.deployStore = deployStore
+	d.newEnvCFN = func(env *config.Environment) (stackDescriber, error) {
+		return envCFN, nil
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+)
+
+const (
+	cfnServiceName         = "cloudformation"
+	appStackNameSuffix     = "-infrastructure-roles"
+	appStackSetSuffix      = "-infrastructure"
+	stackResourcePrefix    = "stack/"
+	stackSetResourcePrefix = "stackset/"
+)
+
+// appARN holds the parts of the ARN of the CloudFormation stack or stack set of an application.
+type appARN struct {
+	App       string
+	Region    string
+	AccountID string
+}
+
+// parseAppARN parses the ARN of the app CloudFormation stack, such as
+// "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1234",
+// or of the app stack set, such as "arn:aws:cloudformation:us-west-2:123456789012:stackset/phonetool-infrastructure:1234".
+func parseAppARN(s string) (*appARN, error) {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("parse ARN %s: %w", s, err)
+	}
+	if parsed.Service != cfnServiceName {
+		return nil, fmt.Errorf("ARN %s is not a CloudFormation ARN", s)
+	}
+	var app string
+	switch {
+	case strings.HasPrefix(parsed.Resource, stackResourcePrefix):
+		name := strings.Split(strings.TrimPrefix(parsed.Resource, stackResourcePrefix), "/")[0]
+		if strings.HasSuffix(name, appStackNameSuffix) {
+			app = strings.TrimSuffix(name, appStackNameSuffix)
+		}
+	case strings.HasPrefix(parsed.Resource, stackSetResourcePrefix):
+		name := strings.Split(strings.TrimPrefix(parsed.Resource, stackSetResourcePrefix), ":")[0]
+		if strings.HasSuffix(name, appStackSetSuffix) {
+			app = strings.TrimSuffix(name, appStackSetSuffix)
+		}
+	}
+	if app == "" {
+		return nil, fmt.Errorf("ARN %s is not the ARN of the stack or stack set of an application", s)
+	}
+	return &appARN{
+		App:       app,
+		Region:    parsed.Region,
+		AccountID: parsed.AccountID,
+	}, nil
+}
+
+// NewAppDescriberFromARN instantiates an application describer from the ARN of the app CloudFormation stack or stack set,
+// for example one received in a CloudFormation event. The application name and home region are parsed from the ARN,
+// which must follow the default stack names. It returns an error if the default credentials belong to another account.
+func NewAppDescriberFromARN(stackARN string, opts ...AppDescriberOption) (*AppDescriber, error) {
+	parsed, err := parseAppARN(stackARN)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := sessions.NewProvider().DefaultWithRegion(parsed.Region)
+	if err != nil {
+		return nil, fmt.Errorf("assume default role for app %s in region %s: %w", parsed.App, parsed.Region, err)
+	}
+	caller, err := identity.New(sess).Get()
+	if err != nil {
+		return nil, fmt.Errorf("get identity of the default role for app %s: %w", parsed.App, err)
+	}
+	if caller.Account != parsed.AccountID {
+		return nil, fmt.Errorf("application %s is in account %s but the default credentials are for account %s",
+			parsed.App, parsed.AccountID, caller.Account)
+	}
+	return NewAppDescriberWithSession(parsed.App, sess, opts...)
+}
@@ -0,0 +1,66 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAppARN(t *testing.T) {
+	testCases := map[string]struct {
+		inARN string
+
+		wantedARN *appARN
+		wantedErr error
+	}{
+		"should parse the ARN of the app stack": {
+			inARN: "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/7a2b3c4d",
+
+			wantedARN: &appARN{App: "phonetool", Region: "us-west-2", AccountID: "123456789012"},
+		},
+		"should parse the ARN of the app stack set": {
+			inARN: "arn:aws:cloudformation:us-east-1:123456789012:stackset/my-app-infrastructure:7a2b3c4d",
+
+			wantedARN: &appARN{App: "my-app", Region: "us-east-1", AccountID: "123456789012"},
+		},
+		"should return error if the ARN is malformed": {
+			inARN: "phonetool-infrastructure-roles",
+
+			wantedErr: errors.New("parse ARN phonetool-infrastructure-roles: arn: invalid prefix"),
+		},
+		"should return error if the ARN is not a CloudFormation ARN": {
+			inARN: "arn:aws:iam::123456789012:role/phonetool-infrastructure-roles",
+
+			wantedErr: errors.New("ARN arn:aws:iam::123456789012:role/phonetool-infrastructure-roles is not a CloudFormation ARN"),
+		},
+		"should return error if the ARN is the ARN of an environment stack": {
+			inARN: "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test/7a2b3c4d",
+
+			wantedErr: errors.New("ARN arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test/7a2b3c4d is not the ARN of the stack or stack set of an application"),
+		},
+		"should return error if the stack name is only the suffix": {
+			inARN: "arn:aws:cloudformation:us-west-2:123456789012:stack/-infrastructure-roles/7a2b3c4d",
+
+			wantedErr: errors.New("ARN arn:aws:cloudformation:us-west-2:123456789012:stack/-infrastructure-roles/7a2b3c4d is not the ARN of the stack or stack set of an application"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual, err := parseAppARN(tc.inARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, actual)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+)
+
+// appCacheFile is the content of a file written by SaveAppToFile.
+type appCacheFile struct {
+	SchemaVersion string    `json:"schemaVersion"`
+	CachedAt      time.Time `json:"cachedAt"`
+	App           *App      `json:"app"`
+}
+
+// SaveAppToFile writes the description of an application to the file at path along with the time it was described at,
+// so that it can be rendered later without any API calls with LoadAppFromFile.
+func SaveAppToFile(path string, app *App, cachedAt time.Time) error {
+	b, err := json.Marshal(&appCacheFile{
+		SchemaVersion: AppJSONSchemaVersion,
+		CachedAt:      cachedAt.UTC(),
+		App:           app,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal description of application %s: %w", app.Name, err)
+	}
+	if err := ioutil.WriteFile(path, b, 0644); err != nil {
+		return fmt.Errorf("write description of application %s to %s: %w", app.Name, path, err)
+	}
+	return nil
+}
+
+// LoadAppFromFile reads the description of an application written by SaveAppToFile. The CachedAt field of the returned App
+// is set to the time the application was described at, so that callers can detect stale descriptions.
+// It returns an error if the file was written with a different AppJSONSchemaVersion.
+func LoadAppFromFile(path string) (*App, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read application description from %s: %w", path, err)
+	}
+	var cache appCacheFile
+	if err := json.Unmarshal(b, &cache); err != nil {
+		return nil, fmt.Errorf("unmarshal application description from %s: %w", path, err)
+	}
+	if cache.SchemaVersion != AppJSONSchemaVersion {
+		return nil, fmt.Errorf("application description in %s has schema version %s instead of %s: describe the application again",
+			path, cache.SchemaVersion, AppJSONSchemaVersion)
+	}
+	if cache.App == nil {
+		return nil, fmt.Errorf("application description in %s is empty", path)
+	}
+	cache.App.CachedAt = &cache.CachedAt
+	return cache.App, nil
+}
@@ -0,0 +1,108 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/dustin/go-humanize"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveAppToFile_LoadAppFromFile(t *testing.T) {
+	// GIVEN
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2021-04-04T12:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	path := filepath.Join(t.TempDir(), "phonetool.json")
+	cachedAt := time.Date(2021, time.April, 3, 12, 0, 0, 0, time.UTC)
+	app := &App{
+		Name: "phonetool",
+		URI:  "https://example.com",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}, Managed: true},
+		},
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+		},
+		Deployments: map[string][]string{"test": {"frontend"}},
+	}
+	wantedJSON, err := app.JSONString()
+	require.NoError(t, err)
+
+	// WHEN
+	err = SaveAppToFile(path, app, cachedAt)
+	require.NoError(t, err)
+	actual, err := LoadAppFromFile(path)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, cachedAt, *actual.CachedAt)
+	actualJSON, err := actual.JSONString()
+	require.NoError(t, err)
+	require.Equal(t, wantedJSON, actualJSON, "expected the same JSON as the described application")
+	require.Equal(t, `About
+
+  Name              phonetool
+  URI               https://example.com
+  Cached At         1 day ago
+`, actual.HumanStringSections(SectionAbout))
+}
+
+func TestLoadAppFromFile(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedError string
+	}{
+		"returns error if the schema version changed": {
+			inContent: `{"schemaVersion":"2021-01-01","cachedAt":"2021-04-03T12:00:00Z","app":{"name":"phonetool"}}`,
+
+			wantedError: "has schema version 2021-01-01 instead of " + AppJSONSchemaVersion + ": describe the application again",
+		},
+		"returns error if the file isn't JSON": {
+			inContent: `name: phonetool`,
+
+			wantedError: "unmarshal application description from",
+		},
+		"returns error if the application is missing": {
+			inContent: `{"schemaVersion":"` + AppJSONSchemaVersion + `","cachedAt":"2021-04-03T12:00:00Z"}`,
+
+			wantedError: "is empty",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			path := filepath.Join(t.TempDir(), "phonetool.json")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tc.inContent), 0644))
+
+			// WHEN
+			_, err := LoadAppFromFile(path)
+
+			// THEN
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.wantedError)
+		})
+	}
+}
+
+func TestLoadAppFromFile_NotExist(t *testing.T) {
+	// WHEN
+	_, err := LoadAppFromFile(filepath.Join(t.TempDir(), "phonetool.json"))
+
+	// THEN
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "read application description from")
+}
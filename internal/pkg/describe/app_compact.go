@@ -0,0 +1,56 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// dropEmptyJSONFields removes the fields of a JSON object whose values are null, an empty array or an empty object,
+// while preserving the order of the remaining fields. Only the top-level fields are removed so that nested values,
+// such as an environment without services in "deployments", are kept as is.
+func dropEmptyJSONFields(b []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if _, err := dec.Token(); err != nil { // Opening brace.
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for written := 0; dec.More(); {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		if isEmptyJSONValue(value) {
+			continue
+		}
+		if written > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeJSONValue(&buf, keyTok); err != nil {
+			return nil, err
+		}
+		buf.WriteByte(':')
+		buf.Write(value)
+		written++
+	}
+	if _, err := dec.Token(); err != nil { // Closing brace.
+		return nil, err
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func isEmptyJSONValue(value json.RawMessage) bool {
+	switch string(bytes.TrimSpace(value)) {
+	case "null", "[]", "{}":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_JSONString_Compact(t *testing.T) {
+	testCases := map[string]struct {
+		inApp  *App
+		inOpts AppRenderOptions
+
+		wantedJSON string
+	}{
+		"should keep the empty sections by default": {
+			inApp: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{{Environment: &config.Environment{Name: "test", Region: "us-west-2"}}},
+			},
+
+			wantedJSON: `{"schemaVersion":"2023-10-01","name":"phonetool","environments":[{"app":"","name":"test","region":"us-west-2","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":"","managed":false}],"services":[],"pipelines":[]}` + "\n",
+		},
+		"should drop the empty sections if compact": {
+			inApp: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{{Environment: &config.Environment{Name: "test", Region: "us-west-2"}}},
+			},
+			inOpts: AppRenderOptions{Compact: true},
+
+			wantedJSON: `{"schemaVersion":"2023-10-01","name":"phonetool","environments":[{"app":"","name":"test","region":"us-west-2","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":"","managed":false}]}` + "\n",
+		},
+		"should keep the empty values nested in a non-empty section if compact": {
+			inApp: &App{
+				Name:        "phonetool",
+				Deployments: map[string][]string{"test": {}},
+			},
+			inOpts: AppRenderOptions{Compact: true},
+
+			wantedJSON: `{"schemaVersion":"2023-10-01","name":"phonetool","deployments":{"test":[]}}` + "\n",
+		},
+		"should drop the empty sections before converting keys to snake case": {
+			inApp: &App{
+				Name:     "phonetool",
+				StackARN: "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1234",
+			},
+			inOpts: AppRenderOptions{Compact: true, OutputProfile: OutputProfileSnakeCase},
+
+			wantedJSON: `{"schema_version":"2023-10-01","name":"phonetool","stack_arn":"arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1234"}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual, err := tc.inApp.JSONStringWithOptions(tc.inOpts)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSON, actual)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// Hosts of the AWS console per partition.
+const (
+	consoleHost      = "console.aws.amazon.com"
+	consoleHostGov   = "console.amazonaws-us-gov.com"
+	consoleHostChina = "console.amazonaws.cn"
+)
+
+// AppConsoleURLs contains the links to the AWS console pages of an application's resources.
+type AppConsoleURLs struct {
+	Stack     string            `json:"stack"`
+	StackSet  string            `json:"stackSet"`
+	Pipelines map[string]string `json:"pipelines,omitempty"` // Pipeline name to the URL of its page.
+}
+
+// ConsoleURLs returns the links to the AWS console pages of the app CloudFormation stack and stack set,
+// and of each pipeline of the application, in the partition and home region of the application.
+func (d *AppDescriber) ConsoleURLs() (*AppConsoleURLs, error) {
+	if d.homeRegionName() == "" {
+		return nil, fmt.Errorf("build console URLs for application %s: the home region is unknown", d.app)
+	}
+	appStack, err := d.describeAppStack()
+	if err != nil {
+		return nil, err
+	}
+	pipelines, _, err := d.pipelines()
+	if err != nil {
+		return nil, err
+	}
+	return d.consoleURLs(aws.StringValue(appStack.StackId), pipelines)
+}
+
+// consoleURLs builds the links to the console pages of the app stack with ID stackID, of the app stack set and of pipelines.
+// The stack page is looked up by stack ID, as the console doesn't resolve a stack name.
+func (d *AppDescriber) consoleURLs(stackID string, pipelines []*PipelineSummary) (*AppConsoleURLs, error) {
+	region := d.homeRegionName()
+	if region == "" {
+		return nil, fmt.Errorf("build console URLs for application %s: the home region is unknown", d.app)
+	}
+	if stackID == "" {
+		return nil, fmt.Errorf("build console URLs for application %s: the ID of app stack %s is unknown", d.app, d.appStackName())
+	}
+	host := consoleHostForRegion(region)
+	urls := &AppConsoleURLs{
+		Stack: fmt.Sprintf("https://%s/cloudformation/home?region=%s#/stacks/stackinfo?stackId=%s",
+			host, region, url.QueryEscape(stackID)),
+		StackSet: fmt.Sprintf("https://%s/cloudformation/home?region=%s#/stacksets/%s/info",
+			host, region, url.PathEscape(d.appStackSetName())),
+	}
+	for _, pipeline := range pipelines {
+		if urls.Pipelines == nil {
+			urls.Pipelines = make(map[string]string)
+		}
+		urls.Pipelines[pipeline.Name] = fmt.Sprintf("https://%s/codesuite/codepipeline/pipelines/%s/view?region=%s",
+			host, url.PathEscape(pipeline.Name), region)
+	}
+	return urls, nil
+}
+
+// consoleHostForRegion returns the host of the AWS console for the partition of region.
+func consoleHostForRegion(region string) string {
+	partition, _ := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	switch partition.ID() {
+	case endpoints.AwsUsGovPartitionID:
+		return consoleHostGov
+	case endpoints.AwsCnPartitionID:
+		return consoleHostChina
+	default:
+		return consoleHost
+	}
+}
+
+func (a *appView) writeConsole(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\n", a.translate("Stack"), a.Console.Stack)
+	fmt.Fprintf(w, "  %s\t%s\n", a.translate("Stack set"), a.Console.StackSet)
+	var names []string
+	for name := range a.Console.Pipelines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %s\t%s\n", name, a.Console.Pipelines[name])
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const testAppStackID = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/6d1c60c0-0d1e-11ec-8b1c-0a1b2c3d4e5f"
+
+func TestAppDescriber_ConsoleURLs(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		inRegion   string
+		setupMocks func(m appDescriberMocks)
+
+		wantedURLs  *AppConsoleURLs
+		wantedError error
+	}{
+		"links to the console of the standard partition": {
+			inRegion: "us-west-2",
+			setupMocks: func(m appDescriberMocks) {
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+					StackId: aws.String(testAppStackID),
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline-phonetool"},
+				}, nil)
+				m.pipelineSvc.EXPECT().LatestExecutionStatus("pipeline-phonetool").Return("Succeeded", nil)
+			},
+
+			wantedURLs: &AppConsoleURLs{
+				Stack:    "https://console.aws.amazon.com/cloudformation/home?region=us-west-2#/stacks/stackinfo?stackId=arn%3Aaws%3Acloudformation%3Aus-west-2%3A123456789012%3Astack%2Fphonetool-infrastructure-roles%2F6d1c60c0-0d1e-11ec-8b1c-0a1b2c3d4e5f",
+				StackSet: "https://console.aws.amazon.com/cloudformation/home?region=us-west-2#/stacksets/phonetool-infrastructure/info",
+				Pipelines: map[string]string{
+					"pipeline-phonetool": "https://console.aws.amazon.com/codesuite/codepipeline/pipelines/pipeline-phonetool/view?region=us-west-2",
+				},
+			},
+		},
+		"links to the GovCloud console": {
+			inRegion: "us-gov-west-1",
+			setupMocks: func(m appDescriberMocks) {
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+					StackId: aws.String(testAppStackID),
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedURLs: &AppConsoleURLs{
+				Stack:    "https://console.amazonaws-us-gov.com/cloudformation/home?region=us-gov-west-1#/stacks/stackinfo?stackId=arn%3Aaws%3Acloudformation%3Aus-west-2%3A123456789012%3Astack%2Fphonetool-infrastructure-roles%2F6d1c60c0-0d1e-11ec-8b1c-0a1b2c3d4e5f",
+				StackSet: "https://console.amazonaws-us-gov.com/cloudformation/home?region=us-gov-west-1#/stacksets/phonetool-infrastructure/info",
+			},
+		},
+		"links to the China console": {
+			inRegion: "cn-north-1",
+			setupMocks: func(m appDescriberMocks) {
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+					StackId: aws.String(testAppStackID),
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedURLs: &AppConsoleURLs{
+				Stack:    "https://console.amazonaws.cn/cloudformation/home?region=cn-north-1#/stacks/stackinfo?stackId=arn%3Aaws%3Acloudformation%3Aus-west-2%3A123456789012%3Astack%2Fphonetool-infrastructure-roles%2F6d1c60c0-0d1e-11ec-8b1c-0a1b2c3d4e5f",
+				StackSet: "https://console.amazonaws.cn/cloudformation/home?region=cn-north-1#/stacksets/phonetool-infrastructure/info",
+			},
+		},
+		"returns error if the region is unknown": {
+			setupMocks: func(m appDescriberMocks) {},
+
+			wantedError: errors.New("build console URLs for application phonetool: the home region is unknown"),
+		},
+		"returns error if fail to describe the app stack": {
+			inRegion: "us-west-2",
+			setupMocks: func(m appDescriberMocks) {
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("describe app stack phonetool-infrastructure-roles: %w", testError),
+		},
+		"returns error if fail to list pipelines": {
+			inRegion: "us-west-2",
+			setupMocks: func(m appDescriberMocks) {
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+					StackId: aws.String(testAppStackID),
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list pipelines in application phonetool: %w", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := appDescriberMocks{
+				pipelineSvc: mocks.NewMockpipelinesGetter(ctrl),
+				cfn:         mocks.NewMockcfn(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &AppDescriber{
+				app:         "phonetool",
+				region:      tc.inRegion,
+				pipelineSvc: m.pipelineSvc,
+				cfn:         m.cfn,
+			}
+
+			// WHEN
+			actual, err := d.ConsoleURLs()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedURLs, actual)
+			}
+		})
+	}
+}
+
+func TestAppDescriber_Describe_ConsoleURLs(t *testing.T) {
+	testCases := map[string]struct {
+		inOpts []AppDescriberOption
+
+		wantedConsole *AppConsoleURLs
+	}{
+		"omits the console links by default": {},
+		"includes the console links with WithConsoleURLs": {
+			inOpts: []AppDescriberOption{WithConsoleURLs()},
+
+			wantedConsole: &AppConsoleURLs{
+				Stack:    "https://console.aws.amazon.com/cloudformation/home?region=us-west-2#/stacks/stackinfo?stackId=arn%3Aaws%3Acloudformation%3Aus-west-2%3A123456789012%3Astack%2Fphonetool-infrastructure-roles%2F6d1c60c0-0d1e-11ec-8b1c-0a1b2c3d4e5f",
+				StackSet: "https://console.aws.amazon.com/cloudformation/home?region=us-west-2#/stacksets/phonetool-infrastructure/info",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := appDescriberMocks{
+				configStore: mocks.NewMockAppConfigStore(ctrl),
+				pipelineSvc: mocks.NewMockpipelinesGetter(ctrl),
+				cfn:         mocks.NewMockcfn(ctrl),
+				stackSetSvc: mocks.NewMockstackSetDescriber(ctrl),
+			}
+			m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			m.configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			m.configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+			m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+			m.stackSetSvc.EXPECT().InstanceSummaries("phonetool-infrastructure").Return(nil, nil)
+			m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+				StackId: aws.String(testAppStackID),
+			}, nil)
+			d := &AppDescriber{
+				app:         "phonetool",
+				region:      "us-west-2",
+				configStore: m.configStore,
+				pipelineSvc: m.pipelineSvc,
+				cfn:         m.cfn,
+				stackSetSvc: m.stackSetSvc,
+			}
+			for _, opt := range tc.inOpts {
+				opt(d)
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedConsole, actual.Console)
+		})
+	}
+}
+
+func TestApp_HumanString_Console(t *testing.T) {
+	// GIVEN
+	app := &App{
+		Name: "phonetool",
+		Console: &AppConsoleURLs{
+			Stack:    "https://console.aws.amazon.com/cloudformation/home?region=us-west-2#/stacks/stackinfo?stackId=phonetool-infrastructure-roles",
+			StackSet: "https://console.aws.amazon.com/cloudformation/home?region=us-west-2#/stacksets/phonetool-infrastructure/info",
+			Pipelines: map[string]string{
+				"pipeline-phonetool": "https://console.aws.amazon.com/codesuite/codepipeline/pipelines/pipeline-phonetool/view?region=us-west-2",
+			},
+		},
+	}
+
+	// WHEN
+	actual := app.HumanStringSections(SectionConsole)
+
+	// THEN
+	require.Equal(t, `Console
+
+  Stack               https://console.aws.amazon.com/cloudformation/home?region=us-west-2#/stacks/stackinfo?stackId=phonetool-infrastructure-roles
+  Stack set           https://console.aws.amazon.com/cloudformation/home?region=us-west-2#/stacksets/phonetool-infrastructure/info
+  pipeline-phonetool  https://console.aws.amazon.com/codesuite/codepipeline/pipelines/pipeline-phonetool/view?region=us-west-2
+`, actual)
+	require.NotContains(t, (&App{Name: "phonetool"}).HumanString(), "Console", "expected no Console section without links")
+}
@@ -0,0 +1,67 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+)
+
+const (
+	// costEstimatePeriod is the period of past costs considered as the monthly cost of an environment.
+	costEstimatePeriod = 30 * 24 * time.Hour
+	defaultCostUnit    = "USD"
+)
+
+// EstimatedCost is a rough estimate of the monthly cost of an environment, based on the costs of its resources
+// over the last 30 days. It misses the resources that aren't tagged and the tags that aren't activated
+// as cost allocation tags, so it is only a starting point.
+type EstimatedCost struct {
+	Amount float64 `json:"amount"`
+	Unit   string  `json:"unit"` // Currency of the amount, such as "USD".
+}
+
+// String returns the rounded amount prefixed with a tilde to mark it as approximate, such as "~12.34 USD".
+func (c *EstimatedCost) String() string {
+	return fmt.Sprintf("~%.2f %s", c.Amount, c.Unit)
+}
+
+// envCosts returns the estimated monthly cost of each environment of the application keyed by environment name.
+// It returns a nil map if the describer can't reach Cost Explorer.
+func (d *AppDescriber) envCosts() (map[string]*EstimatedCost, error) {
+	if d.costSvc == nil {
+		return nil, nil
+	}
+	end := d.now()
+	done := d.traceCall("costexplorer.CostsByTag", d.app)
+	costs, err := d.costSvc.CostsByTag(map[string]string{deploy.AppTagKey: d.app}, deploy.EnvTagKey, end.Add(-costEstimatePeriod), end)
+	done()
+	if err != nil {
+		return nil, fmt.Errorf("estimate monthly cost of the environments in application %s: %w", d.app, err)
+	}
+	estimates := make(map[string]*EstimatedCost)
+	for env, cost := range costs {
+		unit := cost.Unit
+		if unit == "" {
+			unit = defaultCostUnit
+		}
+		estimates[env] = &EstimatedCost{
+			Amount: cost.Amount,
+			Unit:   unit,
+		}
+	}
+	return estimates, nil
+}
+
+// hasEnvCosts returns true if the cost of any environment is estimated.
+func (a *App) hasEnvCosts() bool {
+	for _, env := range a.Envs {
+		if env.EstimatedMonthlyCost != nil {
+			return true
+		}
+	}
+	return false
+}
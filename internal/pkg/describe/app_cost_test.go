@@ -0,0 +1,120 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDescriber_Describe_CostEstimate(t *testing.T) {
+	testNow := time.Date(2021, time.April, 4, 12, 0, 0, 0, time.UTC)
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockcostEstimator)
+
+		wantedEnvs  []*EnvSummary
+		wantedError error
+	}{
+		"estimates the cost of each environment over the last 30 days": {
+			setupMocks: func(m *mocks.MockcostEstimator) {
+				m.EXPECT().CostsByTag(map[string]string{"copilot-application": "phonetool"}, "copilot-environment",
+					time.Date(2021, time.March, 5, 12, 0, 0, 0, time.UTC), testNow).Return(map[string]costexplorer.Cost{
+					"test": {Amount: 12.345, Unit: "USD"},
+					"":     {Amount: 1, Unit: "USD"},
+				}, nil)
+			},
+
+			wantedEnvs: []*EnvSummary{
+				{Environment: &config.Environment{Name: "test"}, EstimatedMonthlyCost: &EstimatedCost{Amount: 12.345, Unit: "USD"}},
+				{Environment: &config.Environment{Name: "prod"}, EstimatedMonthlyCost: &EstimatedCost{Amount: 0, Unit: "USD"}},
+			},
+		},
+		"returns error if fail to get the costs": {
+			setupMocks: func(m *mocks.MockcostEstimator) {
+				m.EXPECT().CostsByTag(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("estimate monthly cost of the environments in application phonetool: %w", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+				{Name: "test"},
+				{Name: "prod"},
+			}, nil)
+			configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+			appCFN := mocks.NewMockcfn(ctrl)
+			appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			costSvc := mocks.NewMockcostEstimator(ctrl)
+			tc.setupMocks(costSvc)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				cfn:         appCFN,
+				costSvc:     costSvc,
+
+				includeCost: true,
+				now: func() time.Time {
+					return testNow
+				},
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEnvs, actual.Envs)
+			}
+		})
+	}
+}
+
+func TestApp_HumanString_EnvCosts(t *testing.T) {
+	// GIVEN
+	app := &App{
+		Name: "phonetool",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "prod", AccountID: "123456789012", Region: "us-east-1"}, EstimatedMonthlyCost: &EstimatedCost{Amount: 12.345, Unit: "USD"}},
+			{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}},
+		},
+	}
+
+	// WHEN
+	actual := app.HumanStringSections(SectionEnvironments)
+	data, err := app.JSONString()
+
+	// THEN
+	require.Equal(t, `Environments (2)
+
+  Name              AccountID           Region              Managed             EstCost
+  ----              ---------           ------              -------             -------
+  prod              123456789012        us-east-1           ✗                   ~12.35 USD
+  test              123456789012        us-west-2           ✗                   -
+
+  Regions: us-east-1 (1), us-west-2 (1)
+`, actual)
+	require.NoError(t, err)
+	require.Contains(t, data, `"estimatedMonthlyCost":{"amount":12.345,"unit":"USD"}`)
+}
@@ -0,0 +1,33 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"sort"
+)
+
+// deployedEnvs returns the sorted names of the environments that a service is deployed to, or an empty slice if it isn't deployed.
+func deployedEnvs(svc string, deployments map[string][]string) []string {
+	envs := []string{}
+	for env, svcs := range deployments {
+		if containsString(svcs, svc) {
+			envs = append(envs, env)
+		}
+	}
+	sort.Strings(envs)
+	return envs
+}
+
+// coverage returns the number of environments of the App that the service is deployed to out of all of its environments,
+// such as "2/3". Only the environments that are part of the App count, so that the coverage matches the Environments section.
+func (a *App) coverage(svc *ServiceSummary) string {
+	var deployed int
+	for _, env := range a.Envs {
+		if containsString(svc.DeployedEnvs, env.Name) {
+			deployed++
+		}
+	}
+	return fmt.Sprintf("%d/%d", deployed, len(a.Envs))
+}
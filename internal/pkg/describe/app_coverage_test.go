@@ -0,0 +1,144 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDescriber_Describe_ServiceCoverage(t *testing.T) {
+	testCases := map[string]struct {
+		withDeployStore bool
+
+		wantedServices []*ServiceSummary
+		wantedError    error
+	}{
+		"lists the environments each service is deployed to": {
+			withDeployStore: true,
+
+			wantedServices: []*ServiceSummary{
+				{Workload: &config.Workload{Name: "api", Type: "Backend Service"}, DeployedEnvs: []string{"prod", "test"}},
+				{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}, DeployedEnvs: []string{"test"}},
+				{Workload: &config.Workload{Name: "worker", Type: "Backend Service"}, DeployedEnvs: []string{}},
+			},
+		},
+		"returns error without a deploy store": {
+			wantedError: errors.New("list environment coverage of services in application phonetool: the deployed services are unknown without a deploy store"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+				{Name: "test"},
+				{Name: "prod"},
+			}, nil)
+			configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+				{Name: "api", Type: "Backend Service"},
+				{Name: "frontend", Type: "Load Balanced Web Service"},
+				{Name: "worker", Type: "Backend Service"},
+			}, nil)
+			cfn := mocks.NewMockcfn(ctrl)
+			cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				cfn:         cfn,
+
+				includeCoverage: true,
+			}
+			if tc.withDeployStore {
+				deployStore := mocks.NewMockDeployedServicesLister(ctrl)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api", "frontend"}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "prod").Return([]string{"api"}, nil)
+				d.deployStore = deployStore
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, d.RenderOptions().ServiceCoverage)
+			require.Equal(t, tc.wantedServices, actual.Services)
+		})
+	}
+}
+
+func TestApp_HumanString_ServiceCoverage(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test", Region: "us-west-2"}},
+			{Environment: &config.Environment{Name: "prod", Region: "us-east-1"}},
+		},
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "api", Type: "Backend Service"}, DeployedEnvs: []string{"prod", "test"}},
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}, DeployedEnvs: []string{"test"}},
+		},
+	}
+	testCases := map[string]struct {
+		inOpts AppRenderOptions
+
+		wanted string
+	}{
+		"renders the number of environments each service is deployed to": {
+			inOpts: AppRenderOptions{ServiceCoverage: true},
+
+			wanted: `Services (2)
+
+  Name              Type                       Coverage
+  ----              ----                       --------
+  api               Backend Service            2/2
+  frontend          Load Balanced Web Service  1/2
+`,
+		},
+		"only counts the rendered environments": {
+			inOpts: AppRenderOptions{ServiceCoverage: true, EnvRegion: "us-east-1"},
+
+			wanted: `Services (2)
+
+  Name              Type                       Coverage
+  ----              ----                       --------
+  api               Backend Service            1/1
+  frontend          Load Balanced Web Service  0/1
+`,
+		},
+		"omits the column by default": {
+			inOpts: AppRenderOptions{},
+
+			wanted: `Services (2)
+
+  Name              Type
+  ----              ----
+  api               Backend Service
+  frontend          Load Balanced Web Service
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := tc.inOpts
+			opts.Sections = []AppSection{SectionServices}
+			require.Equal(t, tc.wanted, app.HumanStringWithOptions(opts))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CSVString returns the environments and services of the App struct as two CSV blocks separated by an empty line,
+// so that they can be imported into a spreadsheet. Each block starts with the same headers as the matching table
+// of HumanString, and items are listed in the same order. Values are quoted as needed by encoding/csv.
+// Environments are sorted, filtered and redacted according to opts.
+func (a *App) CSVString(opts AppRenderOptions) (string, error) {
+	app := a.view(opts).App
+	var b strings.Builder
+
+	envs := [][]string{{"Name", "AccountID", "Region", "Managed"}}
+	for _, env := range app.Envs {
+		envs = append(envs, []string{env.Name, env.AccountID, env.Region, strconv.FormatBool(env.Managed)})
+	}
+	if err := writeCSV(&b, envs); err != nil {
+		return "", fmt.Errorf("write environments of application %s as CSV: %w", a.Name, err)
+	}
+
+	b.WriteString("\n")
+
+	svcs := [][]string{{"Name", "Type"}}
+	for _, svc := range app.Services {
+		svcs = append(svcs, []string{svc.Name, svc.Type})
+	}
+	if err := writeCSV(&b, svcs); err != nil {
+		return "", fmt.Errorf("write services of application %s as CSV: %w", a.Name, err)
+	}
+	return b.String(), nil
+}
+
+func writeCSV(b *strings.Builder, records [][]string) error {
+	w := csv.NewWriter(b)
+	if err := w.WriteAll(records); err != nil {
+		return err
+	}
+	return w.Error()
+}
@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_CSVString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp *App
+
+		wanted string
+	}{
+		"quotes values with commas and quotes": {
+			inApp: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}},
+					{Environment: &config.Environment{Name: "prod", AccountID: "123456789012", Region: "us-east-1"}, Managed: true},
+				},
+				Services: []*ServiceSummary{
+					{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+					{Workload: &config.Workload{Name: `api, "v2"`, Type: "Backend Service"}},
+				},
+			},
+
+			wanted: `Name,AccountID,Region,Managed
+prod,123456789012,us-east-1,true
+test,123456789012,us-west-2,false
+
+Name,Type
+"api, ""v2""",Backend Service
+frontend,Load Balanced Web Service
+`,
+		},
+		"writes only the headers of an empty app": {
+			inApp: &App{Name: "phonetool"},
+
+			wanted: `Name,AccountID,Region,Managed
+
+Name,Type
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual, err := tc.inApp.CSVString(AppRenderOptions{})
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
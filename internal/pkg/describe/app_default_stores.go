@@ -0,0 +1,83 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+)
+
+// defaultStores connects to the copilot config and deploy stores with the default session on first use,
+// so that the describers that never read them, for example to get the version of an application, don't create them.
+type defaultStores struct {
+	once   sync.Once
+	config *config.Store
+	deploy *deploy.Store
+	err    error
+}
+
+func (s *defaultStores) connect() error {
+	s.once.Do(func() {
+		store, err := config.NewStore()
+		if err != nil {
+			s.err = fmt.Errorf("connect to copilot config store: %w", err)
+			return
+		}
+		deployStore, err := deploy.NewStore(store)
+		if err != nil {
+			s.err = fmt.Errorf("connect to copilot deploy store: %w", err)
+			return
+		}
+		s.config, s.deploy = store, deployStore
+	})
+	return s.err
+}
+
+// defaultConfigStore is an AppConfigStore and ConfigStoreSvc that reads the copilot config store of the default session.
+type defaultConfigStore struct {
+	*defaultStores
+}
+
+func (s defaultConfigStore) GetApplication(appName string) (*config.Application, error) {
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+	return s.config.GetApplication(appName)
+}
+
+func (s defaultConfigStore) GetEnvironment(appName string, environmentName string) (*config.Environment, error) {
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+	return s.config.GetEnvironment(appName, environmentName)
+}
+
+func (s defaultConfigStore) ListEnvironments(appName string) ([]*config.Environment, error) {
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+	return s.config.ListEnvironments(appName)
+}
+
+func (s defaultConfigStore) ListServices(appName string) ([]*config.Workload, error) {
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+	return s.config.ListServices(appName)
+}
+
+// defaultDeployStore is a DeployedServicesLister that reads the copilot deploy store of the default session.
+type defaultDeployStore struct {
+	*defaultStores
+}
+
+func (s defaultDeployStore) ListDeployedServices(appName string, envName string) ([]string, error) {
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+	return s.deploy.ListDeployedServices(appName, envName)
+}
@@ -0,0 +1,83 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Diff returns the environments, services and pipelines that were removed from or added to a,
+// in human readable format, to become other. Removed items are prefixed with "-" and added items with "+".
+// Items are sorted so that the output is stable. If there is no difference, Diff returns an empty string.
+// Services are identified by their name and type, for example "- backend (Backend Service)".
+func (a *App) Diff(other *App) string {
+	if other == nil {
+		other = &App{}
+	}
+	var b strings.Builder
+	writeDiffSection(&b, "Environments", a.envNames(), other.envNames())
+	writeDiffSection(&b, "Services", a.svcNames(), other.svcNames())
+	writeDiffSection(&b, "Pipelines", a.pipelineNames(), other.pipelineNames())
+	return b.String()
+}
+
+func (a *App) envNames() []string {
+	var names []string
+	for _, env := range a.Envs {
+		names = append(names, env.Name)
+	}
+	return names
+}
+
+func (a *App) svcNames() []string {
+	var names []string
+	for _, svc := range a.Services {
+		names = append(names, fmt.Sprintf("%s (%s)", svc.Name, svc.Type))
+	}
+	return names
+}
+
+func (a *App) pipelineNames() []string {
+	var names []string
+	for _, pipeline := range a.Pipelines {
+		names = append(names, pipeline.Name)
+	}
+	return names
+}
+
+// writeDiffSection writes the lines that are only in before as removed and the lines that are only in after as added,
+// under the given header. Nothing is written if both lists contain the same lines.
+func writeDiffSection(b *strings.Builder, header string, before, after []string) {
+	removed, added := difference(before, after), difference(after, before)
+	if len(removed) == 0 && len(added) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "%s\n", header)
+	for _, line := range removed {
+		fmt.Fprintf(b, "  - %s\n", line)
+	}
+	for _, line := range added {
+		fmt.Fprintf(b, "  + %s\n", line)
+	}
+}
+
+// difference returns the sorted lines of a that are not in b, without duplicates.
+func difference(a, b []string) []string {
+	inB := make(map[string]bool)
+	for _, line := range b {
+		inB[line] = true
+	}
+	var diff []string
+	for _, line := range a {
+		if inB[line] {
+			continue
+		}
+		inB[line] = true // Report duplicates once.
+		diff = append(diff, line)
+	}
+	sort.Strings(diff)
+	return diff
+}
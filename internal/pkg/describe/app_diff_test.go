@@ -0,0 +1,98 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_Diff(t *testing.T) {
+	before := &App{
+		Name: "phonetool",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test"}},
+		},
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+			{Workload: &config.Workload{Name: "backend", Type: "Backend Service"}},
+		},
+		Pipelines: []*PipelineSummary{
+			{Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool"}},
+		},
+	}
+	testCases := map[string]struct {
+		inOther *App
+
+		wanted string
+	}{
+		"should return an empty string if the descriptions are identical": {
+			inOther: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test"}},
+				},
+				Services: []*ServiceSummary{
+					{Workload: &config.Workload{Name: "backend", Type: "Backend Service"}},
+					{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+				},
+				Pipelines: []*PipelineSummary{
+					{Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool"}},
+				},
+			},
+
+			wanted: "",
+		},
+		"should list removed and added items in order": {
+			inOther: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test"}},
+					{Environment: &config.Environment{Name: "staging"}},
+					{Environment: &config.Environment{Name: "prod"}},
+				},
+				Services: []*ServiceSummary{
+					{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+					{Workload: &config.Workload{Name: "backend", Type: "Load Balanced Web Service"}},
+				},
+				Pipelines: []*PipelineSummary{
+					{Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool"}},
+				},
+			},
+
+			wanted: `Environments
+  + prod
+  + staging
+Services
+  - backend (Backend Service)
+  + backend (Load Balanced Web Service)
+`,
+		},
+		"should list everything as removed if the other description is nil": {
+			inOther: nil,
+
+			wanted: `Environments
+  - test
+Services
+  - backend (Backend Service)
+  - frontend (Load Balanced Web Service)
+Pipelines
+  - pipeline-phonetool
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual := before.Diff(tc.inOther)
+
+			// THEN
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"context"
+	"fmt"
+
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// Drift statuses of the app CloudFormation stack.
+const (
+	DriftInSync  = awscfn.StackDriftStatusInSync
+	DriftDrifted = awscfn.StackDriftStatusDrifted
+	DriftUnknown = awscfn.StackDriftStatusUnknown // The drift wasn't or couldn't be detected for some resources.
+)
+
+// DriftStatus detects whether the resources of the app CloudFormation stack were modified outside of CloudFormation.
+// It returns DriftInSync, DriftDrifted or DriftUnknown. Drift detection is asynchronous and DriftStatus waits until it completes,
+// which usually takes from a few seconds up to minutes.
+func (d *AppDescriber) DriftStatus() (string, error) {
+	return d.DriftStatusWithContext(context.Background())
+}
+
+// DriftStatusWithContext is like DriftStatus but stops waiting for the drift detection once ctx is done.
+func (d *AppDescriber) DriftStatusWithContext(ctx context.Context) (string, error) {
+	if d.driftSvc == nil {
+		return "", fmt.Errorf("detect drift of application %s: the describer has no CloudFormation client that can detect drift", d.app)
+	}
+	appStackName := d.appStackName()
+	done := d.traceCall("cloudformation.DetectDrift", appStackName)
+	status, err := d.driftSvc.DetectDrift(ctx, appStackName)
+	done()
+	if err != nil {
+		return "", fmt.Errorf("detect drift of app stack %s: %w", appStackName, err)
+	}
+	switch status {
+	case DriftInSync, DriftDrifted:
+		return status, nil
+	default:
+		return DriftUnknown, nil
+	}
+}
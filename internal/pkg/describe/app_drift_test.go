@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDescriber_DriftStatus(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		mockStatus string
+		mockErr    error
+
+		wantedStatus string
+		wantedErr    error
+	}{
+		"returns in sync": {
+			mockStatus: "IN_SYNC",
+
+			wantedStatus: DriftInSync,
+		},
+		"returns drifted": {
+			mockStatus: "DRIFTED",
+
+			wantedStatus: DriftDrifted,
+		},
+		"returns unknown if the stack wasn't checked": {
+			mockStatus: "NOT_CHECKED",
+
+			wantedStatus: DriftUnknown,
+		},
+		"wraps error from detecting drift": {
+			mockErr: testError,
+
+			wantedErr: fmt.Errorf("detect drift of app stack phonetool-infrastructure-roles: %w", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockdriftDetector(ctrl)
+			m.EXPECT().DetectDrift(gomock.Any(), "phonetool-infrastructure-roles").Return(tc.mockStatus, tc.mockErr)
+			d := &AppDescriber{
+				app:      "phonetool",
+				driftSvc: m,
+			}
+
+			// WHEN
+			actual, err := d.DriftStatus()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStatus, actual)
+		})
+	}
+}
+
+func TestAppDescriber_DriftStatus_WithoutDetector(t *testing.T) {
+	d := &AppDescriber{app: "phonetool"}
+
+	_, err := d.DriftStatus()
+
+	require.EqualError(t, err, "detect drift of application phonetool: the describer has no CloudFormation client that can detect drift")
+}
+
+func TestAppDescriber_Describe_DriftDetection(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	configStore := mocks.NewMockAppConfigStore(ctrl)
+	configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+	configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+	configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+	appCFN := mocks.NewMockcfn(ctrl)
+	appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+	detector := mocks.NewMockdriftDetector(ctrl)
+	detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-infrastructure-roles").Return("DRIFTED", nil)
+	d := &AppDescriber{
+		app:         "phonetool",
+		configStore: configStore,
+		cfn:         appCFN,
+		driftSvc:    detector,
+
+		includeDrift: true,
+	}
+
+	// WHEN
+	actual, err := d.Describe()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, DriftDrifted, actual.DriftStatus)
+	require.Equal(t, `About
+
+  Name              phonetool
+  URI               (none)
+  Drift             DRIFTED
+`, actual.HumanStringSections(SectionAbout))
+}
@@ -0,0 +1,78 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+)
+
+// dryRunUnavailable is rendered in place of the values that are only known from AWS API calls.
+const dryRunUnavailable = "unavailable (dry run)"
+
+// WithDryRun makes Describe build the description of the application from the config store only,
+// without any CloudFormation, CodePipeline or other AWS API call, so that the rendering of an App can be tried out
+// against a snapshot of the config store, for example with NewAppDescriberFromStore, or in demos.
+//
+// In dry-run mode, Describe only populates the name and URI of the application, the name, account, region and Prod flag
+// of its environments, and the name and type of its services. The application has no pipelines, deployments,
+// stack ARNs, creation times nor console links, the options that retrieve details of the environments and services are ignored,
+// and the App's DryRun field is set so that the About section renders the version as unavailable.
+// Version, VersionInfo and the methods built on them return an error matching ErrUnavailableInDryRun.
+func WithDryRun() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.dryRun = true
+	}
+}
+
+// describeDryRun returns the description of the application from the config store only.
+func (d *AppDescriber) describeDryRun() (*App, error) {
+	app, err := d.configStore.GetApplication(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", d.app, err)
+	}
+	envs, err := d.configStore.ListEnvironments(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", d.app, err)
+	}
+	svcs, err := d.configStore.ListServices(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list services in application %s: %w", d.app, err)
+	}
+	description := &App{
+		Name:      app.Name,
+		URI:       app.Domain,
+		Envs:      []*EnvSummary{},
+		Services:  []*ServiceSummary{},
+		Pipelines: []*PipelineSummary{},
+		Warnings:  []string{fmt.Sprintf("dry run: application %s was described from the config store only, the details that require AWS API calls are omitted", d.app)},
+		DryRun:    true,
+	}
+	for _, env := range envs {
+		description.Envs = append(description.Envs, &EnvSummary{
+			Environment: &config.Environment{
+				Name:      env.Name,
+				AccountID: env.AccountID,
+				Region:    env.Region,
+				Prod:      env.Prod,
+			},
+		})
+	}
+	for _, svc := range svcs {
+		description.Services = append(description.Services, &ServiceSummary{
+			Workload: &config.Workload{
+				Name: svc.Name,
+				Type: svc.Type,
+			},
+		})
+	}
+	description.Normalize()
+	for i, enrich := range d.enrichers {
+		if err := enrich(description); err != nil {
+			return nil, fmt.Errorf("run enricher %d on application %s: %w", i+1, d.app, err)
+		}
+	}
+	return description, nil
+}
@@ -0,0 +1,78 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDescriber_Describe_DryRun(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	configStore := mocks.NewMockAppConfigStore(ctrl)
+	configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", Domain: "example.com"}, nil)
+	configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+		{Name: "test", AccountID: "123456789012", Region: "us-west-2", ManagerRoleARN: "arn:aws:iam::123456789012:role/manager"},
+	}, nil)
+	configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+		{Name: "frontend", Type: "Load Balanced Web Service"},
+	}, nil)
+	// The CloudFormation and pipeline clients have no expectations: any call fails the test.
+	d := NewAppDescriberFromStore("phonetool", configStore, mocks.NewMockcfn(ctrl),
+		WithDryRun(), WithStackARNs(), WithEnvironmentStatus(), WithDeployStore(mocks.NewMockDeployedServicesLister(ctrl)))
+	d.pipelineSvc = mocks.NewMockpipelinesGetter(ctrl)
+
+	// WHEN
+	app, err := d.Describe()
+
+	// THEN
+	require.NoError(t, err)
+	require.True(t, app.DryRun)
+	require.Equal(t, []*EnvSummary{
+		{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}},
+	}, app.Envs)
+	require.Equal(t, []*ServiceSummary{
+		{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+	}, app.Services)
+	require.Empty(t, app.Pipelines)
+	require.Nil(t, app.Deployments)
+	require.Empty(t, app.StackARN)
+	human := app.HumanStringSections(SectionAbout, SectionEnvironments)
+	require.Equal(t, `About
+
+  Name              phonetool
+  URI               alias: example.com
+  Version           unavailable (dry run)
+
+Environments (1)
+
+  Name              AccountID           Region              Managed
+  ----              ---------           ------              -------
+  test              123456789012        us-west-2           -
+`, human)
+	actual, err := app.JSONString()
+	require.NoError(t, err)
+	require.Contains(t, actual, `"dryRun":true`)
+}
+
+func TestAppDescriber_Version_DryRun(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := NewAppDescriberFromStore("phonetool", mocks.NewMockAppConfigStore(ctrl), mocks.NewMockcfn(ctrl), WithDryRun())
+
+	// WHEN
+	_, err := d.Version()
+
+	// THEN
+	require.EqualError(t, err, "get version of application phonetool: unavailable in dry-run mode")
+	require.True(t, errors.Is(err, ErrUnavailableInDryRun))
+}
@@ -0,0 +1,51 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+)
+
+// EnvironmentAgeRange returns the oldest and the newest environments of the application by the creation time of their stacks,
+// for example to find the original environment and the most recently added one. Environments created at the same time
+// are ordered by name. If the application has a single environment, then it is both the oldest and the newest.
+// Environments whose stack has no creation time are sorted after all the others: they are never the newest environment
+// unless no creation time is known at all, in which case the environments are ordered by name.
+//
+// It describes the stack of every environment, which costs one CloudFormation call per environment in its account.
+// It returns an error if the application has no environment or if the describer can't read the environment stacks.
+func (d *AppDescriber) EnvironmentAgeRange() (oldest, newest *config.Environment, err error) {
+	if d.newEnvCFN == nil {
+		return nil, nil, fmt.Errorf("get creation times of the environments in application %s: the describer can't read the environment stacks", d.app)
+	}
+	envs, err := d.configStore.ListEnvironments(d.app)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list environments in application %s: %w", d.app, err)
+	}
+	if len(envs) == 0 {
+		return nil, nil, fmt.Errorf("application %s has no environments", d.app)
+	}
+	summaries := make([]*EnvSummary, len(envs))
+	for i, env := range envs {
+		envStack, err := d.envStack(env)
+		if err != nil {
+			return nil, nil, err
+		}
+		summaries[i] = &EnvSummary{Environment: env, CreationTime: envStack.CreationTime}
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].isOlderThan(summaries[j])
+	})
+	newestSummary := summaries[len(summaries)-1]
+	for i := len(summaries) - 1; i >= 0; i-- {
+		if summaries[i].CreationTime != nil {
+			newestSummary = summaries[i]
+			break
+		}
+	}
+	return summaries[0].Environment, newestSummary.Environment, nil
+}
@@ -0,0 +1,126 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDescriber_EnvironmentAgeRange(t *testing.T) {
+	testError := errors.New("some error")
+	janFirst := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	febFirst := time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		withoutEnvCFN bool
+		setupMocks    func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber)
+
+		wantedOldest string
+		wantedNewest string
+		wantedError  error
+	}{
+		"returns the oldest and the newest environments": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}, {Name: "staging"}}, nil)
+				envCFN.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{CreationTime: &janFirst}, nil)
+				envCFN.EXPECT().Describe("phonetool-prod").Return(&cloudformation.StackDescription{CreationTime: &febFirst}, nil)
+				envCFN.EXPECT().Describe("phonetool-staging").Return(&cloudformation.StackDescription{CreationTime: &janFirst}, nil)
+			},
+
+			wantedOldest: "staging",
+			wantedNewest: "prod",
+		},
+		"never returns an environment without a creation time as the newest": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}, {Name: "staging"}}, nil)
+				envCFN.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{CreationTime: &janFirst}, nil)
+				envCFN.EXPECT().Describe("phonetool-prod").Return(&cloudformation.StackDescription{}, nil)
+				envCFN.EXPECT().Describe("phonetool-staging").Return(&cloudformation.StackDescription{CreationTime: &febFirst}, nil)
+			},
+
+			wantedOldest: "test",
+			wantedNewest: "staging",
+		},
+		"orders the environments by name if no creation time is known": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				envCFN.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{}, nil)
+				envCFN.EXPECT().Describe("phonetool-prod").Return(&cloudformation.StackDescription{}, nil)
+			},
+
+			wantedOldest: "prod",
+			wantedNewest: "test",
+		},
+		"returns the single environment as both the oldest and the newest": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				envCFN.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{CreationTime: &janFirst}, nil)
+			},
+
+			wantedOldest: "test",
+			wantedNewest: "test",
+		},
+		"returns error if the application has no environments": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			},
+
+			wantedError: errors.New("application phonetool has no environments"),
+		},
+		"returns error if fail to describe an environment stack": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				envCFN.EXPECT().Describe("phonetool-test").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("describe stack phonetool-test of environment test: %w", testError),
+		},
+		"returns error if the describer can't read the environment stacks": {
+			withoutEnvCFN: true,
+			setupMocks:    func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber) {},
+
+			wantedError: errors.New("get creation times of the environments in application phonetool: the describer can't read the environment stacks"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			envCFN := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMocks(configStore, envCFN)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+			}
+			if !tc.withoutEnvCFN {
+				d.newEnvCFN = func(env *config.Environment) (stackDescriber, error) {
+					return envCFN, nil
+				}
+			}
+
+			// WHEN
+			oldest, newest, err := d.EnvironmentAgeRange()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOldest, oldest.Name)
+			require.Equal(t, tc.wantedNewest, newest.Name)
+		})
+	}
+}
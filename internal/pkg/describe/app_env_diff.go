@@ -0,0 +1,187 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Attributes of an environment compared by CompareEnvs.
+const (
+	EnvAttributeRegion          = "region"
+	EnvAttributeAccountID       = "accountID"
+	EnvAttributeTemplateVersion = "templateVersion"
+	EnvAttributeManagerRole     = "managerRole"
+	EnvAttributeExecutionRole   = "executionRole"
+)
+
+// EnvDiff holds the attributes that differ between two environments of an application.
+type EnvDiff struct {
+	Base        string              `json:"base"`
+	Target      string              `json:"target"`
+	Differences []*EnvAttributeDiff `json:"differences"`
+	Incomplete  []string            `json:"incomplete,omitempty"` // Reasons why some attributes couldn't be compared.
+}
+
+// EnvAttributeDiff holds the values of an attribute that differs between two environments.
+type EnvAttributeDiff struct {
+	Attribute string `json:"attribute"`
+	Base      string `json:"base"`
+	Target    string `json:"target"`
+}
+
+// envAttributes holds the attributes of an environment compared by CompareEnvs.
+// The attributes read from the environment stack are empty if the stack couldn't be read.
+type envAttributes struct {
+	name             string
+	region           string
+	accountID        string
+	templateVersion  string
+	managerRoleARN   string
+	executionRoleARN string
+	incomplete       bool
+}
+
+// CompareEnvs compares the region, account, template version and roles of the environments a and b,
+// for example to check that a staging environment mirrors the production one. The roles are compared by name
+// with the environment's name left out, since their ARNs always contain the name and account of the environment.
+//
+// The template version and roles are read from the environment stacks. If the stack of an environment can't be read,
+// then CompareEnvs still returns the differences of the other attributes, and lists the reason in EnvDiff.Incomplete.
+func (d *AppDescriber) CompareEnvs(a, b string) (EnvDiff, error) {
+	envs, err := d.configStore.ListEnvironments(d.app)
+	if err != nil {
+		return EnvDiff{}, fmt.Errorf("list environments in application %s: %w", d.app, err)
+	}
+	byName := make(map[string]*config.Environment)
+	for _, env := range envs {
+		byName[env.Name] = env
+	}
+	for _, name := range []string{a, b} {
+		if byName[name] == nil {
+			return EnvDiff{}, fmt.Errorf("environment %s is not part of application %s", name, d.app)
+		}
+	}
+	diff := EnvDiff{
+		Base:        a,
+		Target:      b,
+		Differences: []*EnvAttributeDiff{},
+	}
+	base, target := d.envAttributes(byName[a], &diff), d.envAttributes(byName[b], &diff)
+	diff.compare(EnvAttributeRegion, base.region, target.region)
+	diff.compare(EnvAttributeAccountID, base.accountID, target.accountID)
+	if base.incomplete || target.incomplete {
+		return diff, nil
+	}
+	diff.compare(EnvAttributeTemplateVersion, base.templateVersion, target.templateVersion)
+	diff.compareRoles(EnvAttributeManagerRole, base, target, base.managerRoleARN, target.managerRoleARN)
+	diff.compareRoles(EnvAttributeExecutionRole, base, target, base.executionRoleARN, target.executionRoleARN)
+	return diff, nil
+}
+
+// envAttributes returns the attributes of env. If its stack can't be read, then the reason is added to diff.Incomplete.
+func (d *AppDescriber) envAttributes(env *config.Environment, diff *EnvDiff) *envAttributes {
+	attrs := &envAttributes{
+		name:      env.Name,
+		region:    env.Region,
+		accountID: env.AccountID,
+	}
+	if d.newEnvCFN == nil {
+		attrs.incomplete = true
+		diff.Incomplete = append(diff.Incomplete, fmt.Sprintf("the describer can't read the stack of environment %s", env.Name))
+		return attrs
+	}
+	envStack, err := d.envStack(env)
+	if err == nil {
+		attrs.templateVersion, err = d.envVersion(env)
+	}
+	if err != nil {
+		attrs.incomplete = true
+		diff.Incomplete = append(diff.Incomplete, err.Error())
+		return attrs
+	}
+	outputs := stackOutputs(envStack)
+	attrs.managerRoleARN = outputs[stack.EnvOutputManagerRoleKey]
+	attrs.executionRoleARN = outputs[stack.EnvOutputCFNExecutionRoleARN]
+	return attrs
+}
+
+// compare adds a difference for attribute if the values of the environments are not equal.
+func (diff *EnvDiff) compare(attribute, base, target string) {
+	if base == target {
+		return
+	}
+	diff.Differences = append(diff.Differences, &EnvAttributeDiff{
+		Attribute: attribute,
+		Base:      valueOrDash(base),
+		Target:    valueOrDash(target),
+	})
+}
+
+// compareRoles adds a difference for attribute if the role names differ once the environment names are left out.
+func (diff *EnvDiff) compareRoles(attribute string, base, target *envAttributes, baseARN, targetARN string) {
+	if roleNameWithoutEnv(baseARN, base.name) == roleNameWithoutEnv(targetARN, target.name) {
+		return
+	}
+	diff.compare(attribute, baseARN, targetARN)
+}
+
+// roleNameWithoutEnv returns the name of the role with the ARN roleARN, with envName replaced by a placeholder.
+// The ARN is returned as is if it can't be parsed.
+func roleNameWithoutEnv(roleARN, envName string) string {
+	parsed, err := arn.Parse(roleARN)
+	if err != nil {
+		return roleARN
+	}
+	return strings.ReplaceAll(strings.TrimPrefix(parsed.Resource, "role/"), envName, "{env}")
+}
+
+// HasDifferences returns true if any compared attribute differs between the two environments.
+func (diff EnvDiff) HasDifferences() bool {
+	return len(diff.Differences) > 0
+}
+
+// JSONString returns the stringified EnvDiff struct with json format.
+func (diff EnvDiff) JSONString() (string, error) {
+	b, err := json.Marshal(diff)
+	if err != nil {
+		return "", fmt.Errorf("marshal differences between environments %s and %s: %w", diff.Base, diff.Target, err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified EnvDiff struct with human readable format,
+// with one row per attribute that differs and the reasons why the comparison is incomplete.
+func (diff EnvDiff) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprintf("Differences (%d)\n\n", len(diff.Differences)))
+	if !diff.HasDifferences() {
+		fmt.Fprintf(writer, "  Environments %s and %s have the same attributes.\n", diff.Base, diff.Target)
+	} else {
+		headers := []string{"Attribute", diff.Base, diff.Target}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, d := range diff.Differences {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", d.Attribute, d.Base, d.Target)
+		}
+	}
+	if len(diff.Incomplete) > 0 {
+		fmt.Fprint(writer, color.BoldFgYellow.Sprint("\nIncomplete\n\n"))
+		for _, reason := range diff.Incomplete {
+			fmt.Fprintf(writer, "  - %s\n", reason)
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func envRoleStack(account, env, managerRoleName string) *cloudformation.StackDescription {
+	return &cloudformation.StackDescription{
+		Outputs: []*awscfn.Output{
+			{
+				OutputKey:   aws.String("EnvironmentManagerRoleARN"),
+				OutputValue: aws.String(fmt.Sprintf("arn:aws:iam::%s:role/%s", account, managerRoleName)),
+			},
+			{
+				OutputKey:   aws.String("CFNExecutionRoleARN"),
+				OutputValue: aws.String(fmt.Sprintf("arn:aws:iam::%s:role/phonetool-%s-CFNExecutionRole", account, env)),
+			},
+		},
+	}
+}
+
+func TestAppDescriber_CompareEnvs(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		withoutEnvCFN bool
+		setupMocks    func(envCFN *mocks.MockstackDescriber)
+
+		wantedDiff  EnvDiff
+		wantedError error
+	}{
+		"ignores the names of the environments in the roles of environments that mirror each other": {
+			setupMocks: func(envCFN *mocks.MockstackDescriber) {
+				envCFN.EXPECT().Describe("phonetool-staging").Return(envRoleStack("1111", "staging", "phonetool-staging-EnvManagerRole"), nil)
+				envCFN.EXPECT().Describe("phonetool-prod").Return(envRoleStack("2222", "prod", "phonetool-prod-EnvManagerRole"), nil)
+				envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-staging")).Return("Version: v1.2.0", nil)
+				envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-prod")).Return("Version: v1.2.0", nil)
+			},
+
+			wantedDiff: EnvDiff{
+				Base:        "staging",
+				Target:      "prod",
+				Differences: []*EnvAttributeDiff{{Attribute: EnvAttributeAccountID, Base: "1111", Target: "2222"}},
+			},
+		},
+		"returns the attributes that differ": {
+			setupMocks: func(envCFN *mocks.MockstackDescriber) {
+				envCFN.EXPECT().Describe("phonetool-staging").Return(envRoleStack("1111", "staging", "phonetool-staging-EnvManagerRole"), nil)
+				envCFN.EXPECT().Describe("phonetool-prod").Return(envRoleStack("2222", "prod", "custom-manager"), nil)
+				envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-staging")).Return("Version: v1.3.0", nil)
+				envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-prod")).Return("", nil)
+			},
+
+			wantedDiff: EnvDiff{
+				Base:   "staging",
+				Target: "prod",
+				Differences: []*EnvAttributeDiff{
+					{Attribute: EnvAttributeAccountID, Base: "1111", Target: "2222"},
+					{Attribute: EnvAttributeTemplateVersion, Base: "v1.3.0", Target: "v0.0.0"},
+					{Attribute: EnvAttributeManagerRole, Base: "arn:aws:iam::1111:role/phonetool-staging-EnvManagerRole", Target: "arn:aws:iam::2222:role/custom-manager"},
+				},
+			},
+		},
+		"returns partial results if the stack of an environment can't be read": {
+			setupMocks: func(envCFN *mocks.MockstackDescriber) {
+				envCFN.EXPECT().Describe("phonetool-staging").Return(envRoleStack("1111", "staging", "phonetool-staging-EnvManagerRole"), nil)
+				envCFN.EXPECT().Describe("phonetool-prod").Return(nil, testError)
+				envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-staging")).Return("Version: v1.3.0", nil)
+			},
+
+			wantedDiff: EnvDiff{
+				Base:        "staging",
+				Target:      "prod",
+				Differences: []*EnvAttributeDiff{{Attribute: EnvAttributeAccountID, Base: "1111", Target: "2222"}},
+				Incomplete:  []string{"describe stack phonetool-prod of environment prod: some error"},
+			},
+		},
+		"returns partial results if the describer can't read the environment stacks": {
+			withoutEnvCFN: true,
+
+			wantedDiff: EnvDiff{
+				Base:        "staging",
+				Target:      "prod",
+				Differences: []*EnvAttributeDiff{{Attribute: EnvAttributeAccountID, Base: "1111", Target: "2222"}},
+				Incomplete: []string{
+					"the describer can't read the stack of environment staging",
+					"the describer can't read the stack of environment prod",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+				{Name: "staging", AccountID: "1111", Region: "us-west-2"},
+				{Name: "prod", AccountID: "2222", Region: "us-west-2"},
+			}, nil)
+			envCFN := mocks.NewMockstackDescriber(ctrl)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+			}
+			if !tc.withoutEnvCFN {
+				tc.setupMocks(envCFN)
+				d.newEnvCFN = func(env *config.Environment) (stackDescriber, error) {
+					return envCFN, nil
+				}
+			}
+
+			// WHEN
+			actual, err := d.CompareEnvs("staging", "prod")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDiff, actual)
+		})
+	}
+}
+
+func TestAppDescriber_CompareEnvs_Errors(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		mockEnvs    []*config.Environment
+		mockErr     error
+		wantedError error
+	}{
+		"returns error if fail to list the environments": {
+			mockErr:     testError,
+			wantedError: fmt.Errorf("list environments in application phonetool: %w", testError),
+		},
+		"returns error if an environment is not part of the application": {
+			mockEnvs:    []*config.Environment{{Name: "staging"}},
+			wantedError: errors.New("environment prod is not part of application phonetool"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().ListEnvironments("phonetool").Return(tc.mockEnvs, tc.mockErr)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+			}
+
+			// WHEN
+			_, err := d.CompareEnvs("staging", "prod")
+
+			// THEN
+			require.EqualError(t, err, tc.wantedError.Error())
+		})
+	}
+}
+
+func TestEnvDiff_String(t *testing.T) {
+	diff := EnvDiff{
+		Base:        "staging",
+		Target:      "prod",
+		Differences: []*EnvAttributeDiff{{Attribute: EnvAttributeRegion, Base: "us-west-2", Target: "us-east-1"}},
+		Incomplete:  []string{"the describer can't read the stack of environment prod"},
+	}
+
+	human := diff.HumanString()
+	actual, err := diff.JSONString()
+
+	require.Equal(t, `Differences (1)
+
+  Attribute         staging             prod
+  ---------         -------             ----
+  region            us-west-2           us-east-1
+
+Incomplete
+
+  - the describer can't read the stack of environment prod
+`, human)
+	require.NoError(t, err)
+	require.Equal(t, `{"base":"staging","target":"prod","differences":[{"attribute":"region","base":"us-west-2","target":"us-east-1"}],"incomplete":["the describer can't read the stack of environment prod"]}`+"\n", actual)
+	require.Equal(t, "Differences (0)\n\n  Environments staging and prod have the same attributes.\n", EnvDiff{Base: "staging", Target: "prod"}.HumanString())
+}
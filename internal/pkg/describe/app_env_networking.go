@@ -0,0 +1,87 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+// EnvVPC holds the VPC of an environment.
+type EnvVPC struct {
+	ID         string   `json:"id,omitempty"`
+	Imported   bool     `json:"imported"`             // True if the VPC was imported when the environment was created, false if Copilot manages it.
+	SharedWith []string `json:"sharedWith,omitempty"` // Names of the other environments of the application in the same VPC.
+}
+
+// WithEnvironmentNetworking makes Describe retrieve the VPC of each environment: its ID from the outputs of the environment stack,
+// and whether it was imported or is managed by Copilot from the config store. Environments that share a VPC with other environments
+// of the application list them. The VPCs are rendered as a bullet line under each environment of the Environments section.
+// It makes an extra CloudFormation call per environment, unless another option already describes the environment stacks.
+func WithEnvironmentNetworking() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeNetworking = true
+	}
+}
+
+// envVPC returns the VPC of env. The ID is read from the outputs of envStack if it is known,
+// otherwise it falls back to the ID of the imported VPC in the config store.
+func envVPC(env *config.Environment, envStack *cloudformation.StackDescription) *EnvVPC {
+	vpc := &EnvVPC{}
+	if env.CustomConfig != nil && env.CustomConfig.ImportVPC != nil {
+		vpc.Imported = true
+		vpc.ID = env.CustomConfig.ImportVPC.ID
+	}
+	if envStack != nil {
+		if id := stackOutputs(envStack)[stack.EnvOutputVPCID]; id != "" {
+			vpc.ID = id
+		}
+	}
+	return vpc
+}
+
+// markSharedVPCs sets the names of the other environments in the same VPC for each environment whose VPC ID is known.
+func markSharedVPCs(envs []*EnvSummary) {
+	envsByVPC := make(map[string][]string)
+	for _, env := range envs {
+		if env.VPC == nil || env.VPC.ID == "" {
+			continue
+		}
+		envsByVPC[env.VPC.ID] = append(envsByVPC[env.VPC.ID], env.Name)
+	}
+	for _, env := range envs {
+		if env.VPC == nil || env.VPC.ID == "" {
+			continue
+		}
+		var others []string
+		for _, name := range envsByVPC[env.VPC.ID] {
+			if name != env.Name {
+				others = append(others, name)
+			}
+		}
+		sort.Strings(others)
+		env.VPC.SharedWith = others
+	}
+}
+
+// writeVPC writes a bullet line with the VPC of the environment if it is known.
+func (e *EnvSummary) writeVPC(w io.Writer, indent string) {
+	if e.VPC == nil {
+		return
+	}
+	details := []string{"managed"}
+	if e.VPC.Imported {
+		details = []string{"imported"}
+	}
+	if len(e.VPC.SharedWith) > 0 {
+		details = append(details, fmt.Sprintf("shared with %s", strings.Join(e.VPC.SharedWith, ", ")))
+	}
+	fmt.Fprintf(w, "%s- VPC: %s (%s)\n", indent, valueOrDash(e.VPC.ID), strings.Join(details, ", "))
+}
@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func vpcStack(id string) *cloudformation.StackDescription {
+	return &cloudformation.StackDescription{
+		Outputs: []*awscfn.Output{
+			{OutputKey: aws.String("VpcId"), OutputValue: aws.String(id)},
+		},
+	}
+}
+
+func TestAppDescriber_Describe_EnvironmentNetworking(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	importVPC := &config.CustomizeEnv{ImportVPC: &config.ImportVPC{ID: "vpc-shared"}}
+	configStore := mocks.NewMockAppConfigStore(ctrl)
+	configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+	configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+		{Name: "test", AccountID: "123456789012", Region: "us-west-2", CustomConfig: importVPC},
+		{Name: "staging", AccountID: "123456789012", Region: "us-west-2", CustomConfig: importVPC},
+		{Name: "prod", AccountID: "123456789012", Region: "us-west-2"},
+	}, nil)
+	configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+	appCFN := mocks.NewMockcfn(ctrl)
+	appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+	envCFN := mocks.NewMockstackDescriber(ctrl)
+	envCFN.EXPECT().Describe("phonetool-test").Return(vpcStack("vpc-shared"), nil)
+	envCFN.EXPECT().Describe("phonetool-staging").Return(vpcStack("vpc-shared"), nil)
+	envCFN.EXPECT().Describe("phonetool-prod").Return(vpcStack("vpc-prod"), nil)
+	d := &AppDescriber{
+		app:         "phonetool",
+		configStore: configStore,
+		cfn:         appCFN,
+		newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+			return envCFN, nil
+		},
+
+		includeNetworking: true,
+	}
+
+	// WHEN
+	actual, err := d.Describe()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, &EnvVPC{ID: "vpc-shared", Imported: true, SharedWith: []string{"staging"}}, actual.Envs[0].VPC)
+	require.Equal(t, &EnvVPC{ID: "vpc-shared", Imported: true, SharedWith: []string{"test"}}, actual.Envs[1].VPC)
+	require.Equal(t, &EnvVPC{ID: "vpc-prod"}, actual.Envs[2].VPC)
+	require.Equal(t, `Environments (3)
+
+  Name              AccountID           Region              Managed
+  ----              ---------           ------              -------
+  prod              123456789012        us-west-2           ✗
+    - VPC: vpc-prod (managed)
+  staging           123456789012        us-west-2           ✗
+    - VPC: vpc-shared (imported, shared with test)
+  test              123456789012        us-west-2           ✗
+    - VPC: vpc-shared (imported, shared with staging)
+
+  Regions: us-west-2 (3)
+`, actual.HumanStringSections(SectionEnvironments))
+	out, err := actual.JSONString()
+	require.NoError(t, err)
+	require.Contains(t, out, `"vpc":{"id":"vpc-prod","imported":false}`)
+}
+
+func TestEnvVPC(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv   *config.Environment
+		inStack *cloudformation.StackDescription
+
+		wanted *EnvVPC
+	}{
+		"reads the ID of a managed VPC from the stack": {
+			inEnv:   &config.Environment{Name: "test"},
+			inStack: vpcStack("vpc-1234"),
+
+			wanted: &EnvVPC{ID: "vpc-1234"},
+		},
+		"falls back to the imported VPC of the config store if the stack can't be read": {
+			inEnv: &config.Environment{Name: "test", CustomConfig: &config.CustomizeEnv{ImportVPC: &config.ImportVPC{ID: "vpc-5678"}}},
+
+			wanted: &EnvVPC{ID: "vpc-5678", Imported: true},
+		},
+		"has no ID if the VPC of a managed environment is unknown": {
+			inEnv: &config.Environment{Name: "test"},
+
+			wanted: &EnvVPC{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, envVPC(tc.inEnv, tc.inStack))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"gopkg.in/yaml.v3"
+)
+
+// WithEnvironmentVersions makes Describe read the template version of each environment from the Metadata of its stack,
+// which is rendered as a Version column of the Environments section to spot the environments to upgrade.
+// It makes an extra CloudFormation call per environment.
+func WithEnvironmentVersions() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeEnvVersions = true
+	}
+}
+
+// EnvVersions returns the template version of each environment of the application keyed by environment name.
+// Environments deployed before templates were versioned have the deploy.LegacyEnvTemplateVersion version.
+func (d *AppDescriber) EnvVersions() (map[string]string, error) {
+	if d.newEnvCFN == nil {
+		return nil, fmt.Errorf("get template versions of the environments in application %s: the describer can't read the environment stacks", d.app)
+	}
+	envs, err := d.configStore.ListEnvironments(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", d.app, err)
+	}
+	versions := make(map[string]string)
+	for _, env := range envs {
+		version, err := d.envVersion(env)
+		if err != nil {
+			return nil, err
+		}
+		versions[env.Name] = version
+	}
+	return versions, nil
+}
+
+// envVersion returns the template version of the environment from the Metadata.Version field of its stack,
+// or deploy.LegacyEnvTemplateVersion if the field does not exist.
+func (d *AppDescriber) envVersion(env *config.Environment) (string, error) {
+	client, err := d.envCFN(env)
+	if err != nil {
+		return "", fmt.Errorf("new CloudFormation client for environment %s: %w", env.Name, err)
+	}
+	envStackName := d.envStackName(env.Name)
+	done := d.traceCall("cloudformation.Metadata", envStackName)
+	raw, err := client.Metadata(cloudformation.MetadataWithStackName(envStackName))
+	done()
+	if err != nil {
+		return "", fmt.Errorf("get metadata of stack %s of environment %s: %w", envStackName, env.Name, err)
+	}
+	metadata := struct {
+		Version string `yaml:"Version"`
+	}{}
+	if err := yaml.Unmarshal([]byte(raw), &metadata); err != nil {
+		return "", fmt.Errorf("unmarshal metadata of stack %s to read Version: %w", envStackName, err)
+	}
+	if metadata.Version == "" {
+		return deploy.LegacyEnvTemplateVersion, nil
+	}
+	return metadata.Version, nil
+}
+
+// hasEnvVersions returns true if the template version of any environment is known.
+func (a *App) hasEnvVersions() bool {
+	for _, env := range a.Envs {
+		if env.TemplateVersion != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDescriber_EnvVersions(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		withoutEnvCFN bool
+		setupMocks    func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber)
+
+		wantedVersions map[string]string
+		wantedError    error
+	}{
+		"returns the version of each environment with the legacy version as fallback": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-test")).Return("Version: v1.2.0", nil)
+				envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-prod")).Return("", nil)
+			},
+
+			wantedVersions: map[string]string{
+				"test": "v1.2.0",
+				"prod": "v0.0.0",
+			},
+		},
+		"returns error if fail to get the metadata of an environment stack": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-test")).Return("", testError)
+			},
+
+			wantedError: fmt.Errorf("get metadata of stack phonetool-test of environment test: %w", testError),
+		},
+		"returns error if the environment stacks can't be read": {
+			withoutEnvCFN: true,
+			setupMocks:    func(configStore *mocks.MockAppConfigStore, envCFN *mocks.MockstackDescriber) {},
+
+			wantedError: errors.New("get template versions of the environments in application phonetool: the describer can't read the environment stacks"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			envCFN := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMocks(configStore, envCFN)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+			}
+			if !tc.withoutEnvCFN {
+				d.newEnvCFN = func(env *config.Environment) (stackDescriber, error) {
+					return envCFN, nil
+				}
+			}
+
+			// WHEN
+			actual, err := d.EnvVersions()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVersions, actual)
+		})
+	}
+}
+
+func TestAppDescriber_Describe_EnvironmentVersions(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	configStore := mocks.NewMockAppConfigStore(ctrl)
+	configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+	configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+		{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+		{Name: "prod", AccountID: "123456789012", Region: "us-west-2"},
+	}, nil)
+	configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+	appCFN := mocks.NewMockcfn(ctrl)
+	appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+	envCFN := mocks.NewMockstackDescriber(ctrl)
+	envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-test")).Return("Version: v1.2.0", nil)
+	envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-prod")).Return("", nil)
+	d := &AppDescriber{
+		app:         "phonetool",
+		configStore: configStore,
+		cfn:         appCFN,
+		newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+			return envCFN, nil
+		},
+
+		includeEnvVersions: true,
+	}
+
+	// WHEN
+	actual, err := d.Describe()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `Environments (2)
+
+  Name              AccountID           Region              Managed             Version
+  ----              ---------           ------              -------             -------
+  prod              123456789012        us-west-2           ✗                   v0.0.0
+  test              123456789012        us-west-2           ✗                   v1.2.0
+
+  Regions: us-west-2 (2)
+`, actual.HumanStringSections(SectionEnvironments))
+}
+
+func TestAppDescriber_EnvCFN_AssumesRoleOncePerEnvironment(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	configStore := mocks.NewMockAppConfigStore(ctrl)
+	configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+	configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+		{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+		{Name: "prod", AccountID: "210987654321", Region: "us-east-1"},
+	}, nil).Times(2)
+	configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+	appCFN := mocks.NewMockcfn(ctrl)
+	appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+	envCFN := mocks.NewMockstackDescriber(ctrl)
+	envCFN.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{}, nil)
+	envCFN.EXPECT().Describe("phonetool-prod").Return(&cloudformation.StackDescription{}, nil)
+	envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-test")).Return("Version: v1.2.0", nil).Times(2)
+	envCFN.EXPECT().Metadata(cloudformation.MetadataWithStackName("phonetool-prod")).Return("Version: v1.2.0", nil).Times(2)
+	assumed := make(map[string]int)
+	d := &AppDescriber{
+		app:         "phonetool",
+		configStore: configStore,
+		cfn:         appCFN,
+		newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+			assumed[env.Name]++
+			return envCFN, nil
+		},
+
+		includeEnvStatus:   true,
+		includeEnvVersions: true,
+	}
+
+	// WHEN
+	_, err := d.Describe()
+	require.NoError(t, err)
+	_, err = d.EnvVersions()
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, map[string]int{"test": 1, "prod": 1}, assumed, "expected the role of each environment to be assumed once")
+}
@@ -0,0 +1,69 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DeploymentRecord is a denormalized deployment of a service to an environment of an application.
+type DeploymentRecord struct {
+	App       string `json:"app"`
+	Env       string `json:"environment"`
+	Region    string `json:"region"`
+	AccountID string `json:"accountID"`
+	Service   string `json:"service"`
+	Type      string `json:"type"`
+}
+
+// Flatten returns one record per service deployed in an environment, sorted by environment name then service name.
+// It requires the deployed services of each environment, which are only listed if the describer has a deploy store,
+// and returns an empty slice otherwise.
+func (a *App) Flatten() []DeploymentRecord {
+	svcTypes := make(map[string]string)
+	for _, svc := range a.Services {
+		svcTypes[svc.Name] = svc.Type
+	}
+	records := []DeploymentRecord{}
+	for _, env := range a.sorted(false).Envs {
+		svcs := append([]string(nil), a.Deployments[env.Name]...)
+		sort.Strings(svcs)
+		for _, svc := range svcs {
+			records = append(records, DeploymentRecord{
+				App:       a.Name,
+				Env:       env.Name,
+				Region:    env.Region,
+				AccountID: env.AccountID,
+				Service:   svc,
+				Type:      svcTypes[svc],
+			})
+		}
+	}
+	return records
+}
+
+// FlatJSONString returns the records of Flatten as a JSON array.
+func (a *App) FlatJSONString() (string, error) {
+	b, err := json.Marshal(a.Flatten())
+	if err != nil {
+		return "", fmt.Errorf("marshal deployments of application %s: %w", a.Name, err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// FlatCSVString returns the records of Flatten as a CSV block with a header line.
+func (a *App) FlatCSVString() (string, error) {
+	rows := [][]string{{"App", "Environment", "Region", "AccountID", "Service", "Type"}}
+	for _, r := range a.Flatten() {
+		rows = append(rows, []string{r.App, r.Env, r.Region, r.AccountID, r.Service, r.Type})
+	}
+	var b strings.Builder
+	if err := writeCSV(&b, rows); err != nil {
+		return "", fmt.Errorf("write deployments of application %s as CSV: %w", a.Name, err)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,85 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_Flatten(t *testing.T) {
+	testCases := map[string]struct {
+		inApp *App
+
+		wanted     []DeploymentRecord
+		wantedCSV  string
+		wantedJSON string
+	}{
+		"returns one record per deployment": {
+			inApp: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}},
+					{Environment: &config.Environment{Name: "prod", AccountID: "210987654321", Region: "us-east-1"}},
+				},
+				Services: []*ServiceSummary{
+					{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+					{Workload: &config.Workload{Name: "api", Type: "Backend Service"}},
+				},
+				Deployments: map[string][]string{
+					"test": {"frontend", "api"},
+					"prod": {"frontend"},
+				},
+			},
+
+			wanted: []DeploymentRecord{
+				{App: "phonetool", Env: "prod", Region: "us-east-1", AccountID: "210987654321", Service: "frontend", Type: "Load Balanced Web Service"},
+				{App: "phonetool", Env: "test", Region: "us-west-2", AccountID: "123456789012", Service: "api", Type: "Backend Service"},
+				{App: "phonetool", Env: "test", Region: "us-west-2", AccountID: "123456789012", Service: "frontend", Type: "Load Balanced Web Service"},
+			},
+			wantedCSV: `App,Environment,Region,AccountID,Service,Type
+phonetool,prod,us-east-1,210987654321,frontend,Load Balanced Web Service
+phonetool,test,us-west-2,123456789012,api,Backend Service
+phonetool,test,us-west-2,123456789012,frontend,Load Balanced Web Service
+`,
+			wantedJSON: `[{"app":"phonetool","environment":"prod","region":"us-east-1","accountID":"210987654321","service":"frontend","type":"Load Balanced Web Service"},` +
+				`{"app":"phonetool","environment":"test","region":"us-west-2","accountID":"123456789012","service":"api","type":"Backend Service"},` +
+				`{"app":"phonetool","environment":"test","region":"us-west-2","accountID":"123456789012","service":"frontend","type":"Load Balanced Web Service"}]` + "\n",
+		},
+		"returns no records without deployments": {
+			inApp: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test"}},
+				},
+				Services: []*ServiceSummary{
+					{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+				},
+			},
+
+			wanted: []DeploymentRecord{},
+			wantedCSV: `App,Environment,Region,AccountID,Service,Type
+`,
+			wantedJSON: "[]\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual := tc.inApp.Flatten()
+			actualCSV, errCSV := tc.inApp.FlatCSVString()
+			actualJSON, errJSON := tc.inApp.FlatJSONString()
+
+			// THEN
+			require.Equal(t, tc.wanted, actual)
+			require.NoError(t, errCSV)
+			require.Equal(t, tc.wantedCSV, actualCSV)
+			require.NoError(t, errJSON)
+			require.Equal(t, tc.wantedJSON, actualJSON)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// formatFuncs are the functions available to the templates of Format on top of the text/template builtins.
+var formatFuncs = template.FuncMap{
+	"join":  strings.Join, // {{join .AccountIDs ", "}}
+	"count": countOf,      // {{count (len .Envs) "env" "envs"}} renders "1 env" or "2 envs".
+	"dash":  valueOrDash,  // {{dash .URI}} renders "-" if the URI is empty.
+}
+
+// Format executes the text/template tmpl against the App struct and returns the result, so that callers can render
+// the description in their own format. All the fields and methods of App are available, for example
+// "{{.Name}}: {{range .Envs}}{{.Name}} {{end}}", as well as the join, count and dash functions.
+// Environments and services are sorted, filtered and redacted according to opts, the same as in HumanStringWithOptions.
+func (a *App) Format(tmpl string, opts AppRenderOptions) (string, error) {
+	t, err := template.New("format").Funcs(formatFuncs).Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("parse format template: %w", err)
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, a.view(opts).App); err != nil {
+		return "", fmt.Errorf("execute format template on application %s: %w", a.Name, err)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,73 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_Format(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test", Region: "us-west-2", AccountID: "123456789012"}},
+			{Environment: &config.Environment{Name: "prod", Region: "us-east-1", AccountID: "123456789012"}},
+		},
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+		},
+	}
+	testCases := map[string]struct {
+		inRedaction Redaction
+		inTemplate  string
+
+		wanted      string
+		wantedError string
+	}{
+		"renders the fields with the environments sorted": {
+			inTemplate: `{{.Name}}:{{range .Envs}} {{.Name}}@{{.Region}}{{end}}`,
+
+			wanted: "phonetool: prod@us-east-1 test@us-west-2",
+		},
+		"provides the helper functions": {
+			inTemplate: `{{count (len .Envs) "env" "envs"}}, {{count (len .Services) "svc" "svcs"}} in {{join .AccountIDs ", "}} (uri: {{dash .URI}})`,
+
+			wanted: "2 envs, 1 svc in 123456789012 (uri: -)",
+		},
+		"redacts the sensitive values": {
+			inRedaction: RedactAccountIDs,
+			inTemplate:  `{{range .Envs}}{{.AccountID}} {{end}}`,
+
+			wanted: "****9012 ****9012 ",
+		},
+		"returns error if the template can't be parsed": {
+			inTemplate: `{{.Name`,
+
+			wantedError: `parse format template: template: format:1: unclosed action`,
+		},
+		"returns error if the template can't be executed": {
+			inTemplate: `{{.Owner}}`,
+
+			wantedError: `execute format template on application phonetool: template: format:1:2: executing "format" at <.Owner>: can't evaluate field Owner in type *describe.App`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual, err := app.Format(tc.inTemplate, AppRenderOptions{Redaction: tc.inRedaction})
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
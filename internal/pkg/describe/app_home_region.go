@@ -0,0 +1,158 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+)
+
+// homeRegionClients creates the CloudFormation and stack set clients of the application's home region on first use.
+// The home region is the region recorded in the config store for the application, or the region of the session
+// for applications created before the region was recorded. Resolving it lazily means that a describer can be created
+// without a region, and only the calls that need the app stack or stack set fail.
+type homeRegionClients struct {
+	app         string
+	sess        *session.Session
+	appRegion   func() (string, error) // Returns the region recorded for the application, empty if none is recorded.
+	maxAttempts int                    // Maximum number of attempts of the CloudFormation requests, zero to use the retryer of sess.
+
+	once     sync.Once
+	region   string
+	cfn      *cloudformation.CloudFormation
+	stackSet *stackset.StackSet
+	err      error
+}
+
+// resolve returns the home region of the application, and creates the clients of the region the first time it's called.
+func (c *homeRegionClients) resolve() (string, error) {
+	c.once.Do(func() {
+		region, err := c.appRegion()
+		if err != nil {
+			c.err = fmt.Errorf("resolve home region of application %s: %w", c.app, err)
+			return
+		}
+		if region == "" {
+			region = aws.StringValue(c.sess.Config.Region)
+		}
+		if region == "" {
+			c.err = fmt.Errorf("resolve home region of application %s: no region is configured", c.app)
+			return
+		}
+		sess, err := homeRegionCFNSession(c.app, region, c.sess)
+		if err != nil {
+			c.err = err
+			return
+		}
+		c.region = region
+		c.cfn = cloudformation.New(withMaxAttempts(sess, c.maxAttempts))
+		c.stackSet = stackset.New(sess)
+	})
+	return c.region, c.err
+}
+
+// withMaxAttempts returns a copy of sess whose retryer sends a request up to attempts times, or sess if attempts is zero.
+func withMaxAttempts(sess *session.Session, attempts int) *session.Session {
+	if attempts < 1 {
+		return sess
+	}
+	return sess.Copy(&aws.Config{MaxRetries: aws.Int(attempts - 1)})
+}
+
+// homeRegionCFN is a cfn and driftDetector that calls CloudFormation in the home region of the application.
+type homeRegionCFN struct {
+	*homeRegionClients
+}
+
+func (c homeRegionCFN) client() (*cloudformation.CloudFormation, error) {
+	if _, err := c.resolve(); err != nil {
+		return nil, err
+	}
+	return c.cfn, nil
+}
+
+func (c homeRegionCFN) Describe(name string) (*cloudformation.StackDescription, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.Describe(name)
+}
+
+func (c homeRegionCFN) StackResources(name string) ([]*cloudformation.StackResource, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.StackResources(name)
+}
+
+func (c homeRegionCFN) Metadata(opt cloudformation.MetadataOpts) (string, error) {
+	client, err := c.client()
+	if err != nil {
+		return "", err
+	}
+	return client.Metadata(opt)
+}
+
+func (c homeRegionCFN) MetadataWithContext(ctx context.Context, opt cloudformation.MetadataOpts) (string, error) {
+	client, err := c.client()
+	if err != nil {
+		return "", err
+	}
+	return client.MetadataWithContext(ctx, opt)
+}
+
+func (c homeRegionCFN) TemplateBodyFromChangeSet(changeSetID, stackName string) (string, error) {
+	client, err := c.client()
+	if err != nil {
+		return "", err
+	}
+	return client.TemplateBodyFromChangeSet(changeSetID, stackName)
+}
+
+func (c homeRegionCFN) DetectDrift(ctx context.Context, stackName string) (string, error) {
+	client, err := c.client()
+	if err != nil {
+		return "", err
+	}
+	return client.DetectDrift(ctx, stackName)
+}
+
+// homeRegionStackSet is a stackSetDescriber that calls CloudFormation in the home region of the application.
+type homeRegionStackSet struct {
+	*homeRegionClients
+}
+
+func (c homeRegionStackSet) Describe(name string) (stackset.Description, error) {
+	if _, err := c.resolve(); err != nil {
+		return stackset.Description{}, err
+	}
+	return c.stackSet.Describe(name)
+}
+
+func (c homeRegionStackSet) InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error) {
+	if _, err := c.resolve(); err != nil {
+		return nil, err
+	}
+	return c.stackSet.InstanceSummaries(name, opts...)
+}
+
+// homeRegionName returns the home region of the application, empty if it's unknown.
+func (d *AppDescriber) homeRegionName() string {
+	if d.homeRegion == nil {
+		return d.region
+	}
+	region, err := d.homeRegion.resolve()
+	if err != nil {
+		return ""
+	}
+	return region
+}
@@ -0,0 +1,186 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHomeRegionClients_Resolve(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		inConfig      *aws.Config
+		mockRegion    string
+		mockRegionErr error
+
+		wantedRegion string
+		wantedError  error
+	}{
+		"uses the region recorded for the application over the region of the session": {
+			inConfig:   &aws.Config{Region: aws.String("us-west-2")},
+			mockRegion: "eu-west-1",
+
+			wantedRegion: "eu-west-1",
+		},
+		"falls back to the region of the session if the application has no recorded region": {
+			inConfig: &aws.Config{Region: aws.String("us-west-2")},
+
+			wantedRegion: "us-west-2",
+		},
+		"returns an error if there is no region": {
+			inConfig: &aws.Config{},
+
+			wantedError: errors.New("resolve home region of application phonetool: no region is configured"),
+		},
+		"returns a wrapped error if the region of the application can't be read": {
+			inConfig:      &aws.Config{Region: aws.String("us-west-2")},
+			mockRegionErr: testError,
+
+			wantedError: fmt.Errorf("resolve home region of application phonetool: %w", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			calls := 0
+			c := &homeRegionClients{
+				app:  "phonetool",
+				sess: &session.Session{Config: tc.inConfig},
+				appRegion: func() (string, error) {
+					calls++
+					return tc.mockRegion, tc.mockRegionErr
+				},
+			}
+
+			// WHEN
+			region, err := c.resolve()
+			_, secondErr := c.resolve()
+
+			// THEN
+			require.Equal(t, 1, calls)
+			require.Equal(t, err, secondErr)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRegion, region)
+			require.NotNil(t, c.cfn)
+			require.NotNil(t, c.stackSet)
+		})
+	}
+}
+
+func TestHomeRegionCFN_NoRegion(t *testing.T) {
+	// GIVEN
+	clients := &homeRegionClients{
+		app:  "phonetool",
+		sess: &session.Session{Config: &aws.Config{}},
+		appRegion: func() (string, error) {
+			return "", nil
+		},
+	}
+	d := &AppDescriber{
+		app:         "phonetool",
+		homeRegion:  clients,
+		cfn:         homeRegionCFN{clients},
+		stackSetSvc: homeRegionStackSet{clients},
+	}
+
+	// WHEN
+	_, describeErr := d.cfn.Describe("phonetool-infrastructure-roles")
+	_, metadataErr := d.cfn.Metadata(cloudformation.MetadataWithStackName("phonetool-infrastructure-roles"))
+	_, stackSetErr := d.stackSetSvc.Describe("phonetool-infrastructure")
+
+	// THEN
+	wanted := "resolve home region of application phonetool: no region is configured"
+	require.EqualError(t, describeErr, wanted)
+	require.EqualError(t, metadataErr, wanted)
+	require.EqualError(t, stackSetErr, wanted)
+	require.Empty(t, d.homeRegionName())
+}
+
+func TestAppDescriber_Describe_SessionInOtherRegion(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	configStore := mocks.NewMockAppConfigStore(ctrl)
+	configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", Region: "us-west-2"}, nil).AnyTimes()
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-east-1"),
+		Credentials: credentials.AnonymousCredentials,
+	})
+	require.NoError(t, err)
+	d, err := NewAppDescriberWithSession("phonetool", sess, WithConfigStore(configStore), WithDeployStore(mocks.NewMockDeployedServicesLister(ctrl)))
+	require.NoError(t, err)
+
+	// WHEN
+	_, err = d.Describe()
+
+	// THEN
+	require.EqualError(t, err, "application phonetool lives in region us-west-2 but the default session is in region us-east-1: set the AWS_REGION environment variable or use a profile with region us-west-2")
+}
+
+func TestWithMaxAttempts(t *testing.T) {
+	testCases := map[string]struct {
+		inAttempts int
+
+		wantedMaxRetries int
+	}{
+		"keeps the retryer of the session by default": {
+			wantedMaxRetries: 5,
+		},
+		"retries a request one time less than the number of attempts": {
+			inAttempts: 2,
+
+			wantedMaxRetries: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			sess, err := session.NewSession(&aws.Config{
+				Region:      aws.String("us-west-2"),
+				Credentials: credentials.AnonymousCredentials,
+				MaxRetries:  aws.Int(5),
+			})
+			require.NoError(t, err)
+
+			// WHEN
+			actual := withMaxAttempts(sess, tc.inAttempts)
+
+			// THEN
+			require.Equal(t, tc.wantedMaxRetries, aws.IntValue(actual.Config.MaxRetries))
+		})
+	}
+}
+
+func TestNewAppDescriberWithSession_MaxMetadataAttempts(t *testing.T) {
+	// GIVEN
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Credentials: credentials.AnonymousCredentials,
+	})
+	require.NoError(t, err)
+
+	// WHEN
+	d, err := NewAppDescriberWithSession("phonetool", sess, WithMaxMetadataAttempts(2))
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, 2, d.homeRegion.maxAttempts, "expected the attempts to be applied to the CloudFormation client of the home region")
+}
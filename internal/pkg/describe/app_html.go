@@ -0,0 +1,87 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+)
+
+// HTMLString returns the About, Environments, Services and Pipelines sections of the App struct
+// as self-contained HTML, with one <table> per section preceded by an <h2> header.
+// All values are HTML-escaped, and items are listed in the same order as in HumanString.
+// Unlike HumanString, times are formatted with RFC 3339 since the output is meant to be published.
+// Environments are sorted, filtered and redacted according to opts.
+func (a *App) HTMLString(opts AppRenderOptions) string {
+	app := a.view(opts).App
+	var b strings.Builder
+	b.WriteString("<section>\n")
+
+	var about [][]string
+	uri := app.URI
+	if uri == "" {
+		uri = "(none)"
+	}
+	about = append(about, []string{"Name", app.Name}, []string{"URI", uri})
+	if accounts := app.AccountIDs(); len(accounts) > 1 {
+		about = append(about, []string{"Accounts", strings.Join(accounts, ", ")})
+	}
+	if app.CreationTime != nil {
+		about = append(about, []string{"Created At", app.CreationTime.Format(time.RFC3339)})
+	}
+	if app.LastUpdatedTime != nil {
+		about = append(about, []string{"Updated At", app.LastUpdatedTime.Format(time.RFC3339)})
+	}
+	writeHTMLTable(&b, "About", nil, about)
+
+	var envs [][]string
+	for _, env := range app.Envs {
+		managed := app.managedMark(env)
+		envs = append(envs, []string{env.Name, env.AccountID, env.Region, managed})
+	}
+	writeHTMLTable(&b, fmt.Sprintf("Environments (%d)", len(envs)), []string{"Name", "AccountID", "Region", "Managed"}, envs)
+
+	var svcs [][]string
+	for _, svc := range app.Services {
+		svcs = append(svcs, []string{svc.Name, svc.Type})
+	}
+	writeHTMLTable(&b, fmt.Sprintf("Services (%d)", len(svcs)), []string{"Name", "Type"}, svcs)
+
+	var pipelines [][]string
+	for _, pipeline := range app.Pipelines {
+		pipelines = append(pipelines, []string{pipeline.Name, valueOrDash(pipeline.Repository), valueOrDash(pipeline.Branch), valueOrDash(pipeline.Status)})
+	}
+	writeHTMLTable(&b, fmt.Sprintf("Pipelines (%d)", len(pipelines)), []string{"Name", "Repository", "Branch", "LatestStatus"}, pipelines)
+
+	b.WriteString("</section>\n")
+	return b.String()
+}
+
+// writeHTMLTable writes a header followed by a table with the escaped headers and rows.
+// If there are no headers, then the first cell of each row is written as a row header instead.
+func writeHTMLTable(b *strings.Builder, header string, headers []string, rows [][]string) {
+	fmt.Fprintf(b, "<h2>%s</h2>\n<table>\n", html.EscapeString(header))
+	if len(headers) > 0 {
+		b.WriteString("<thead><tr>")
+		for _, h := range headers {
+			fmt.Fprintf(b, "<th>%s</th>", html.EscapeString(h))
+		}
+		b.WriteString("</tr></thead>\n")
+	}
+	b.WriteString("<tbody>\n")
+	for _, row := range rows {
+		b.WriteString("<tr>")
+		for i, cell := range row {
+			if i == 0 && len(headers) == 0 {
+				fmt.Fprintf(b, `<th scope="row">%s</th>`, html.EscapeString(cell))
+				continue
+			}
+			fmt.Fprintf(b, "<td>%s</td>", html.EscapeString(cell))
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</tbody>\n</table>\n")
+}
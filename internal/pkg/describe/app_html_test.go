@@ -0,0 +1,114 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_HTMLString(t *testing.T) {
+	testCreationTime := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inApp *App
+
+		wanted string
+	}{
+		"escapes all values": {
+			inApp: &App{
+				Name: "phonetool",
+				URI:  `https://example.com/?a=1&b="<script>"`,
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}, Managed: true},
+				},
+				Services: []*ServiceSummary{
+					{Workload: &config.Workload{Name: "<b>frontend</b>", Type: "Load Balanced Web Service"}},
+				},
+				Pipelines: []*PipelineSummary{
+					{Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool", Repository: "o'connor/phonetool"}, Status: "Succeeded"},
+				},
+				CreationTime: &testCreationTime,
+			},
+
+			wanted: `<section>
+<h2>About</h2>
+<table>
+<tbody>
+<tr><th scope="row">Name</th><td>phonetool</td></tr>
+<tr><th scope="row">URI</th><td>https://example.com/?a=1&amp;b=&#34;&lt;script&gt;&#34;</td></tr>
+<tr><th scope="row">Created At</th><td>2021-03-01T12:00:00Z</td></tr>
+</tbody>
+</table>
+<h2>Environments (1)</h2>
+<table>
+<thead><tr><th>Name</th><th>AccountID</th><th>Region</th><th>Managed</th></tr></thead>
+<tbody>
+<tr><td>test</td><td>123456789012</td><td>us-west-2</td><td>✓</td></tr>
+</tbody>
+</table>
+<h2>Services (1)</h2>
+<table>
+<thead><tr><th>Name</th><th>Type</th></tr></thead>
+<tbody>
+<tr><td>&lt;b&gt;frontend&lt;/b&gt;</td><td>Load Balanced Web Service</td></tr>
+</tbody>
+</table>
+<h2>Pipelines (1)</h2>
+<table>
+<thead><tr><th>Name</th><th>Repository</th><th>Branch</th><th>LatestStatus</th></tr></thead>
+<tbody>
+<tr><td>pipeline-phonetool</td><td>o&#39;connor/phonetool</td><td>-</td><td>Succeeded</td></tr>
+</tbody>
+</table>
+</section>
+`,
+		},
+		"renders empty tables for an empty app": {
+			inApp: &App{Name: "phonetool"},
+
+			wanted: `<section>
+<h2>About</h2>
+<table>
+<tbody>
+<tr><th scope="row">Name</th><td>phonetool</td></tr>
+<tr><th scope="row">URI</th><td>(none)</td></tr>
+</tbody>
+</table>
+<h2>Environments (0)</h2>
+<table>
+<thead><tr><th>Name</th><th>AccountID</th><th>Region</th><th>Managed</th></tr></thead>
+<tbody>
+</tbody>
+</table>
+<h2>Services (0)</h2>
+<table>
+<thead><tr><th>Name</th><th>Type</th></tr></thead>
+<tbody>
+</tbody>
+</table>
+<h2>Pipelines (0)</h2>
+<table>
+<thead><tr><th>Name</th><th>Repository</th><th>Branch</th><th>LatestStatus</th></tr></thead>
+<tbody>
+</tbody>
+</table>
+</section>
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual := tc.inApp.HTMLString(AppRenderOptions{})
+
+			// THEN
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+)
+
+// staleDeploymentAge is the age after which the latest deployment of a service is flagged as stale.
+const staleDeploymentAge = 90 * 24 * time.Hour
+
+// WithServiceLastDeployed makes Describe read the last time each service was deployed from the update time of its stack
+// in every environment it is deployed to, and keep the most recent one. It is rendered as a LastDeployed column of the
+// Services section, where services that weren't deployed for more than 90 days are flagged as stale.
+// It requires a deploy store, and it makes an extra CloudFormation call per service and environment.
+func WithServiceLastDeployed() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.includeLastDeployed = true
+	}
+}
+
+// serviceLastDeployed returns the most recent time the service stack was created or updated across the environments
+// that it is deployed to, or nil if it is unknown.
+func (d *AppDescriber) serviceLastDeployed(svc string, envs []*config.Environment, deployments map[string][]string) (*time.Time, error) {
+	if d.newEnvCFN == nil || deployments == nil {
+		return nil, nil
+	}
+	var last *time.Time
+	for _, env := range envs {
+		if !containsString(deployments[env.Name], svc) {
+			continue
+		}
+		client, err := d.envCFN(env)
+		if err != nil {
+			return nil, fmt.Errorf("new CloudFormation client for environment %s: %w", env.Name, err)
+		}
+		svcStackName := d.svcStackName(env.Name, svc)
+		done := d.traceCall("cloudformation.Describe", svcStackName)
+		desc, err := client.Describe(svcStackName)
+		done()
+		if err != nil {
+			return nil, fmt.Errorf("describe stack %s: %w", svcStackName, err)
+		}
+		deployed := desc.LastUpdatedTime
+		if deployed == nil {
+			deployed = desc.CreationTime
+		}
+		if deployed != nil && (last == nil || deployed.After(*last)) {
+			last = deployed
+		}
+	}
+	return last, nil
+}
+
+// lastDeployed returns how long ago the service was last deployed, flagged if it is stale, or a dash if it is unknown.
+func (s *ServiceSummary) lastDeployed() string {
+	if s.LastDeployed == nil {
+		return "-"
+	}
+	ago := humanizeTime(*s.LastDeployed)
+	if time.Since(*s.LastDeployed) > staleDeploymentAge {
+		return ago + " (stale)"
+	}
+	return ago
+}
+
+// hasLastDeployed returns true if the last deployment time of any service is known.
+func (a *App) hasLastDeployed() bool {
+	for _, svc := range a.Services {
+		if svc.LastDeployed != nil {
+			return true
+		}
+	}
+	return false
+}
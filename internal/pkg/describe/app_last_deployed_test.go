@@ -0,0 +1,137 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDescriber_Describe_ServiceLastDeployed(t *testing.T) {
+	testError := errors.New("some error")
+	created := time.Date(2021, time.January, 1, 12, 0, 0, 0, time.UTC)
+	updated := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		withoutDeployStore bool
+		setupEnvCFN        func(m *mocks.MockstackDescriber)
+
+		wantedServices []*ServiceSummary
+		wantedError    error
+	}{
+		"keeps the most recent deployment across environments": {
+			setupEnvCFN: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Describe("phonetool-test-frontend").Return(&cloudformation.StackDescription{CreationTime: &created, LastUpdatedTime: &updated}, nil)
+				m.EXPECT().Describe("phonetool-prod-frontend").Return(&cloudformation.StackDescription{CreationTime: &created}, nil)
+				m.EXPECT().Describe("phonetool-test-worker").Return(&cloudformation.StackDescription{CreationTime: &created}, nil)
+			},
+
+			wantedServices: []*ServiceSummary{
+				{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}, LastDeployed: &updated},
+				{Workload: &config.Workload{Name: "worker", Type: "Backend Service"}, LastDeployed: &created},
+			},
+		},
+		"skips the deployment times without a deploy store": {
+			withoutDeployStore: true,
+			setupEnvCFN:        func(m *mocks.MockstackDescriber) {},
+
+			wantedServices: []*ServiceSummary{
+				{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+				{Workload: &config.Workload{Name: "worker", Type: "Backend Service"}},
+			},
+		},
+		"returns error if fail to describe a service stack": {
+			setupEnvCFN: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Describe("phonetool-test-frontend").Return(nil, testError)
+				// The other services are still enriched concurrently.
+				m.EXPECT().Describe("phonetool-test-worker").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			},
+
+			wantedError: fmt.Errorf("describe stack phonetool-test-frontend: %w", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+				{Name: "test"},
+				{Name: "prod"},
+			}, nil)
+			configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+				{Name: "frontend", Type: "Load Balanced Web Service"},
+				{Name: "worker", Type: "Backend Service"},
+			}, nil)
+			appCFN := mocks.NewMockcfn(ctrl)
+			appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			envCFN := mocks.NewMockstackDescriber(ctrl)
+			tc.setupEnvCFN(envCFN)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				cfn:         appCFN,
+				newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+					return envCFN, nil
+				},
+
+				includeLastDeployed: true,
+			}
+			if !tc.withoutDeployStore {
+				deployStore := mocks.NewMockDeployedServicesLister(ctrl)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend", "worker"}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "prod").Return([]string{"frontend"}, nil)
+				d.deployStore = deployStore
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedServices, actual.Services)
+			}
+		})
+	}
+}
+
+func TestApp_HumanString_LastDeployed(t *testing.T) {
+	// GIVEN
+	recent := time.Now().Add(-72 * time.Hour)
+	old := time.Now().Add(-400 * 24 * time.Hour)
+	app := &App{
+		Name: "phonetool",
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "api", Type: "Backend Service"}},
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}, LastDeployed: &recent},
+			{Workload: &config.Workload{Name: "worker", Type: "Backend Service"}, LastDeployed: &old},
+		},
+	}
+
+	// WHEN
+	actual := app.HumanStringSections(SectionServices)
+
+	// THEN
+	require.Equal(t, `Services (3)
+
+  Name              Type                       LastDeployed
+  ----              ----                       ------------
+  api               Backend Service            -
+  frontend          Load Balanced Web Service  3 days ago
+  worker            Backend Service            1 year ago (stale)
+`, actual)
+}
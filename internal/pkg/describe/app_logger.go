@@ -0,0 +1,37 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import "time"
+
+// Logger is the minimal interface of the loggers that trace the AWS calls of an AppDescriber.
+// It is satisfied by the standard library's *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// WithLogger makes the describer log each of its CloudFormation, CodePipeline and Cost Explorer calls along with
+// the resource it is made against and its duration, to find out which call dominates the latency of Describe.
+// Nothing is logged by default.
+func WithLogger(logger Logger) AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.logger = logger
+	}
+}
+
+// traceCall starts timing an API call, and returns the function to call once the call returned
+// to log its duration. It is a no-op if the describer has no logger.
+func (d *AppDescriber) traceCall(call, resource string) func() {
+	if d.logger == nil {
+		return func() {}
+	}
+	now := d.now
+	if now == nil {
+		now = time.Now
+	}
+	start := now()
+	return func() {
+		d.logger.Printf("%s %s took %s", call, resource, now().Sub(start))
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingLogger struct {
+	lines []string
+}
+
+func (l *recordingLogger) Printf(format string, v ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func TestAppDescriber_WithLogger(t *testing.T) {
+	testCases := map[string]struct {
+		withLogger bool
+
+		wantedLines []string
+	}{
+		"logs nothing without a logger": {},
+		"logs each call with its duration": {
+			withLogger: true,
+
+			wantedLines: []string{
+				"codepipeline.GetPipelinesByTags phonetool took 50ms",
+				"codepipeline.LatestExecutionStatus pipeline-phonetool took 50ms",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockpipelinesGetter(ctrl)
+			m.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return([]*codepipeline.Pipeline{
+				{Name: "pipeline-phonetool"},
+			}, nil)
+			m.EXPECT().LatestExecutionStatus("pipeline-phonetool").Return("Succeeded", nil)
+			clock := time.Date(2021, time.April, 1, 12, 0, 0, 0, time.UTC)
+			logger := &recordingLogger{}
+			d := &AppDescriber{
+				app:         "phonetool",
+				pipelineSvc: m,
+				now: func() time.Time {
+					clock = clock.Add(50 * time.Millisecond)
+					return clock
+				},
+			}
+			if tc.withLogger {
+				WithLogger(logger)(d)
+			}
+
+			// WHEN
+			_, err := d.PipelinesOnly()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedLines, logger.lines)
+		})
+	}
+}
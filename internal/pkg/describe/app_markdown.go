@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MarkdownString returns the Environments, Services and Pipelines sections of the App struct as GitHub-flavored Markdown tables,
+// each preceded by a "##" header, to paste into issues and runbooks. The tables have the same columns and items are listed
+// in the same order as in HumanString. Pipe characters in values are escaped so that they don't split cells.
+// The tables are rendered according to opts, except for the layout of HumanStringOptions.
+func (a *App) MarkdownString(opts AppRenderOptions) string {
+	app := a.view(opts)
+	var b strings.Builder
+
+	headers, rows := app.envTable()
+	writeMarkdownTable(&b, fmt.Sprintf("Environments (%d)", len(app.Envs)), headers, rows)
+	b.WriteString("\n")
+
+	headers, rows = app.svcTable()
+	writeMarkdownTable(&b, fmt.Sprintf("Services (%d)", len(app.Services)), headers, rows)
+	b.WriteString("\n")
+
+	var pipelines [][]string
+	for _, pipeline := range app.Pipelines {
+		pipelines = append(pipelines, []string{pipeline.Name, valueOrDash(pipeline.Repository), valueOrDash(pipeline.Branch), valueOrDash(pipeline.Status)})
+	}
+	writeMarkdownTable(&b, fmt.Sprintf("Pipelines (%d)", len(app.Pipelines)), []string{"Name", "Repository", "Branch", "LatestStatus"}, pipelines)
+	return b.String()
+}
+
+// writeMarkdownTable writes a header followed by a table with the escaped headers and rows.
+func writeMarkdownTable(b *strings.Builder, header string, headers []string, rows [][]string) {
+	fmt.Fprintf(b, "## %s\n\n", header)
+	writeMarkdownRow(b, headers)
+	separators := make([]string, len(headers))
+	for i := range separators {
+		separators[i] = "---"
+	}
+	fmt.Fprintf(b, "| %s |\n", strings.Join(separators, " | "))
+	for _, row := range rows {
+		writeMarkdownRow(b, row)
+	}
+}
+
+func writeMarkdownRow(b *strings.Builder, cells []string) {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = escapeMarkdownCell(cell)
+	}
+	fmt.Fprintf(b, "| %s |\n", strings.Join(escaped, " | "))
+}
+
+// escapeMarkdownCell escapes pipe characters and replaces line breaks, which would otherwise end the row, with spaces.
+func escapeMarkdownCell(cell string) string {
+	cell = strings.ReplaceAll(cell, "|", `\|`)
+	return strings.NewReplacer("\r\n", " ", "\n", " ").Replace(cell)
+}
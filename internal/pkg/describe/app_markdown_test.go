@@ -0,0 +1,93 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_MarkdownString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp  *App
+		inOpts AppRenderOptions
+
+		wanted string
+	}{
+		"renders the sections as tables": {
+			inApp: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}, Managed: true},
+					{Environment: &config.Environment{Name: "prod", AccountID: "123456789012", Region: "us-east-1"}, Status: EnvHealthy},
+				},
+				Services: []*ServiceSummary{
+					{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+				},
+				Pipelines: []*PipelineSummary{
+					{Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool", Repository: "phonetool", Branch: "main"}, Status: "Failed"},
+				},
+			},
+
+			wanted: `## Environments (2)
+
+| Name | AccountID | Region | Managed | Status |
+| --- | --- | --- | --- | --- |
+| prod | 123456789012 | us-east-1 | ✗ | healthy |
+| test | 123456789012 | us-west-2 | ✓ | - |
+
+## Services (1)
+
+| Name | Type |
+| --- | --- |
+| frontend | Load Balanced Web Service |
+
+## Pipelines (1)
+
+| Name | Repository | Branch | LatestStatus |
+| --- | --- | --- | --- |
+| pipeline-phonetool | phonetool | main | Failed |
+`,
+		},
+		"escapes pipe characters": {
+			inApp: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}, Tags: map[string]string{"team": "a|b"}},
+				},
+				Pipelines: []*PipelineSummary{
+					{Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool", Branch: "feature|x"}},
+				},
+			},
+			inOpts: AppRenderOptions{EnvTagColumns: []string{"team"}},
+
+			wanted: `## Environments (1)
+
+| Name | AccountID | Region | Managed | team |
+| --- | --- | --- | --- | --- |
+| test | 123456789012 | us-west-2 | ✗ | a\|b |
+
+## Services (0)
+
+| Name | Type |
+| --- | --- |
+
+## Pipelines (1)
+
+| Name | Repository | Branch | LatestStatus |
+| --- | --- | --- | --- |
+| pipeline-phonetool | - | feature\|x | - |
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.inApp.MarkdownString(tc.inOpts))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// OutputProfile selects the casing of the keys in the JSON representation of an App.
+type OutputProfile int
+
+// Output profiles of the JSON representation of an App.
+const (
+	OutputProfileCamelCase OutputProfile = iota // The keys of the JSON tags, such as "accountID".
+	OutputProfileSnakeCase                      // The keys of the JSON tags converted to snake case, such as "account_id".
+)
+
+// dataMapKeys are the keys of the JSON objects whose own keys are data, such as environment names or tag keys,
+// instead of field names. Their keys are kept as is whatever the profile.
+var dataMapKeys = map[string]bool{
+	"deployments": true,
+	"tags":        true,
+	"urls":        true,
+	"rollouts":    true,
+	"pipelines":   true, // Only an object under "console", the top-level pipelines are a list.
+	"extra":       true,
+}
+
+// marshalJSON returns the JSON encoding of the App with the keys cased according to the OutputProfile of the view.
+// The empty sections are dropped if the view is Compact.
+func (a *appView) marshalJSON() ([]byte, error) {
+	b, err := a.marshalData()
+	if err != nil {
+		return nil, err
+	}
+	if a.Compact {
+		if b, err = dropEmptyJSONFields(b); err != nil {
+			return nil, fmt.Errorf("drop empty sections: %w", err)
+		}
+	}
+	if a.OutputProfile == OutputProfileCamelCase {
+		return b, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var buf bytes.Buffer
+	if err := renameJSONKeys(dec, &buf, snakeCase, true); err != nil {
+		return nil, fmt.Errorf("convert keys to snake case: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// renameJSONKeys copies the next JSON value from dec to buf while preserving the order of the keys.
+// The keys of an object are converted with rename if renameKeys is true. Nested objects always have their keys
+// converted unless they are the value of one of the dataMapKeys.
+func renameJSONKeys(dec *json.Decoder, buf *bytes.Buffer, rename func(string) string, renameKeys bool) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	switch tok {
+	case json.Delim('{'):
+		buf.WriteByte('{')
+		for i := 0; dec.More(); i++ {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			keyTok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key := keyTok.(string)
+			name := key
+			if renameKeys {
+				name = rename(key)
+			}
+			if err := writeJSONValue(buf, name); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := renameJSONKeys(dec, buf, rename, !dataMapKeys[key]); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		buf.WriteByte('}')
+	case json.Delim('['):
+		buf.WriteByte('[')
+		for i := 0; dec.More(); i++ {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := renameJSONKeys(dec, buf, rename, true); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		buf.WriteByte(']')
+	default:
+		return writeJSONValue(buf, tok)
+	}
+	return nil
+}
+
+func writeJSONValue(buf *bytes.Buffer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
+
+// snakeCase converts a camel case key to snake case. A run of upper case letters is an acronym,
+// optionally pluralized with a trailing "s": "registryURL" becomes "registry_url", "publicSubnetIDs" becomes "public_subnet_ids".
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			startsWord := unicode.IsLower(prev) || unicode.IsDigit(prev)
+			if unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+				isPlural := runes[i+1] == 's' && (i+2 == len(runes) || unicode.IsUpper(runes[i+2]))
+				startsWord = !isPlural
+			}
+			if startsWord {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,180 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_JSONString_OutputProfile(t *testing.T) {
+	testTime := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	newApp := func() *App {
+		return &App{
+			Name: "phonetool",
+			URI:  "https://example.com",
+			Envs: []*EnvSummary{
+				{
+					Environment: &config.Environment{
+						App:              "phonetool",
+						Name:             "test",
+						Region:           "us-west-2",
+						AccountID:        "123456789012",
+						RegistryURL:      "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool",
+						ExecutionRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+						ManagerRoleARN:   "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+						CustomConfig: &config.CustomizeEnv{
+							ImportVPC: &config.ImportVPC{ID: "vpc-1234", PublicSubnetIDs: []string{"subnet-1"}, PrivateSubnetIDs: []string{"subnet-2"}},
+							VPCConfig: &config.AdjustVPC{CIDR: "10.0.0.0/16", PublicSubnetCIDRs: []string{"10.0.0.0/24"}, PrivateSubnetCIDRs: []string{"10.0.1.0/24"}},
+						},
+					},
+					Managed: true,
+					Tags:    map[string]string{"costCenter": "1234"},
+					Status:  EnvHealthy,
+				},
+			},
+			Services: []*ServiceSummary{
+				{
+					Workload: &config.Workload{App: "phonetool", Name: "frontend", Type: "Load Balanced Web Service"},
+					URLs:     map[string]string{"testEnv": "https://test.example.com"},
+				},
+			},
+			Deployments: map[string][]string{"testEnv": {"frontend"}},
+			Pipelines: []*PipelineSummary{
+				{
+					Pipeline: &codepipeline.Pipeline{
+						Name:       "pipeline-phonetool",
+						Region:     "us-west-2",
+						AccountID:  "123456789012",
+						Repository: "phonetool",
+						Branch:     "main",
+						Stages:     []*codepipeline.Stage{{Name: "Source", Category: "Source", Provider: "GitHub", Details: "Repository: phonetool"}},
+						CreatedAt:  testTime,
+						UpdatedAt:  testTime,
+					},
+					Status: "Succeeded",
+				},
+			},
+			StackARN:        "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1",
+			StackSetARN:     "arn:aws:cloudformation:us-west-2:123456789012:stackset/phonetool-infrastructure:1",
+			CreationTime:    &testTime,
+			LastUpdatedTime: &testTime,
+			Warnings:        []string{"something is off"},
+			Console: &AppConsoleURLs{
+				Stack:     "https://console.aws.amazon.com/stack",
+				StackSet:  "https://console.aws.amazon.com/stackset",
+				Pipelines: map[string]string{"pipelineName": "https://console.aws.amazon.com/pipeline"},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		inProfile OutputProfile
+
+		wanted string
+	}{
+		"keeps the keys of the JSON tags by default": {
+			inProfile: OutputProfileCamelCase,
+
+			wanted: `{"schemaVersion":"2023-10-01","name":"phonetool","uri":"https://example.com",` +
+				`"environments":[{"app":"phonetool","name":"test","region":"us-west-2","accountID":"123456789012","prod":false,` +
+				`"registryURL":"123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool",` +
+				`"executionRoleARN":"arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",` +
+				`"managerRoleARN":"arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",` +
+				`"customConfig":{"importVPC":{"id":"vpc-1234","publicSubnetIDs":["subnet-1"],"privateSubnetIDs":["subnet-2"]},` +
+				`"adjustVPC":{"cidr":"10.0.0.0/16","publicSubnetCIDRs":["10.0.0.0/24"],"privateSubnetCIDRs":["10.0.1.0/24"]}},` +
+				`"managed":true,"tags":{"costCenter":"1234"},"status":"healthy"}],` +
+				`"services":[{"app":"phonetool","name":"frontend","type":"Load Balanced Web Service","urls":{"testEnv":"https://test.example.com"}}],` +
+				`"deployments":{"testEnv":["frontend"]},` +
+				`"pipelines":[{"name":"pipeline-phonetool","region":"us-west-2","accountId":"123456789012","repository":"phonetool","branch":"main",` +
+				`"stages":[{"name":"Source","category":"Source","provider":"GitHub","details":"Repository: phonetool"}],` +
+				`"createdAt":"2021-03-01T12:00:00Z","updatedAt":"2021-03-01T12:00:00Z","status":"Succeeded"}],` +
+				`"stackARN":"arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1",` +
+				`"stackSetARN":"arn:aws:cloudformation:us-west-2:123456789012:stackset/phonetool-infrastructure:1",` +
+				`"creationTime":"2021-03-01T12:00:00Z","lastUpdatedTime":"2021-03-01T12:00:00Z",` +
+				`"warnings":["something is off"],` +
+				`"console":{"stack":"https://console.aws.amazon.com/stack","stackSet":"https://console.aws.amazon.com/stackset","pipelines":{"pipelineName":"https://console.aws.amazon.com/pipeline"}}}` + "\n",
+		},
+		"converts the keys to snake case but not the keys of maps": {
+			inProfile: OutputProfileSnakeCase,
+
+			wanted: `{"schema_version":"2023-10-01","name":"phonetool","uri":"https://example.com",` +
+				`"environments":[{"app":"phonetool","name":"test","region":"us-west-2","account_id":"123456789012","prod":false,` +
+				`"registry_url":"123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool",` +
+				`"execution_role_arn":"arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",` +
+				`"manager_role_arn":"arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",` +
+				`"custom_config":{"import_vpc":{"id":"vpc-1234","public_subnet_ids":["subnet-1"],"private_subnet_ids":["subnet-2"]},` +
+				`"adjust_vpc":{"cidr":"10.0.0.0/16","public_subnet_cidrs":["10.0.0.0/24"],"private_subnet_cidrs":["10.0.1.0/24"]}},` +
+				`"managed":true,"tags":{"costCenter":"1234"},"status":"healthy"}],` +
+				`"services":[{"app":"phonetool","name":"frontend","type":"Load Balanced Web Service","urls":{"testEnv":"https://test.example.com"}}],` +
+				`"deployments":{"testEnv":["frontend"]},` +
+				`"pipelines":[{"name":"pipeline-phonetool","region":"us-west-2","account_id":"123456789012","repository":"phonetool","branch":"main",` +
+				`"stages":[{"name":"Source","category":"Source","provider":"GitHub","details":"Repository: phonetool"}],` +
+				`"created_at":"2021-03-01T12:00:00Z","updated_at":"2021-03-01T12:00:00Z","status":"Succeeded"}],` +
+				`"stack_arn":"arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1",` +
+				`"stack_set_arn":"arn:aws:cloudformation:us-west-2:123456789012:stackset/phonetool-infrastructure:1",` +
+				`"creation_time":"2021-03-01T12:00:00Z","last_updated_time":"2021-03-01T12:00:00Z",` +
+				`"warnings":["something is off"],` +
+				`"console":{"stack":"https://console.aws.amazon.com/stack","stack_set":"https://console.aws.amazon.com/stackset","pipelines":{"pipelineName":"https://console.aws.amazon.com/pipeline"}}}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual, err := newApp().JSONStringWithOptions(AppRenderOptions{OutputProfile: tc.inProfile})
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
+
+func TestApp_JSONStringIndent_OutputProfile(t *testing.T) {
+	// GIVEN
+	app := &App{
+		Name:        "phonetool",
+		StackSetARN: "arn",
+	}
+
+	// WHEN
+	actual, err := app.JSONStringIndent(AppRenderOptions{OutputProfile: OutputProfileSnakeCase})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `{
+  "schema_version": "2023-10-01",
+  "name": "phonetool",
+  "environments": [],
+  "services": [],
+  "pipelines": [],
+  "stack_set_arn": "arn"
+}
+`, actual)
+}
+
+func TestSnakeCase(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"single word":       {in: "name", wanted: "name"},
+		"two words":         {in: "schemaVersion", wanted: "schema_version"},
+		"trailing acronym":  {in: "registryURL", wanted: "registry_url"},
+		"plural acronym":    {in: "publicSubnetIDs", wanted: "public_subnet_ids"},
+		"leading acronym":   {in: "URLPath", wanted: "url_path"},
+		"acronym in middle": {in: "importVPCConfig", wanted: "import_vpc_config"},
+		"with digits":       {in: "ipv6CIDR", wanted: "ipv6_cidr"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, snakeCase(tc.in))
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+)
+
+// Redaction selects the sensitive values of an App that are masked when it is serialized.
+type Redaction int
+
+// Redactions of the output formats of an App.
+const (
+	RedactNone              Redaction = iota // All values are serialized as is.
+	RedactAccountIDs                         // Account IDs, including the ones in ARNs, are masked except for their last 4 digits.
+	RedactAccountIDsAndURIs                  // Like RedactAccountIDs, and the URI of the application, the endpoints of its environments and the URLs of its services are masked.
+)
+
+const (
+	redactedMask  = "****"
+	redactedValue = "[redacted]"
+)
+
+// redacted returns a copy of the App whose sensitive values are masked according to redaction,
+// or the App itself if nothing is redacted. The App is left as is so that only its serializations are redacted.
+func (a *App) redacted(redaction Redaction) *App {
+	if redaction == RedactNone {
+		return a
+	}
+	r := *a
+	if a.Envs != nil {
+		r.Envs = make([]*EnvSummary, len(a.Envs))
+		for i, env := range a.Envs {
+			redactedEnv := *env
+			if env.Environment != nil {
+				redactedEnv.Environment = redactEnvironment(env.Environment)
+			}
+			r.Envs[i] = &redactedEnv
+		}
+	}
+	if a.Pipelines != nil {
+		r.Pipelines = make([]*PipelineSummary, len(a.Pipelines))
+		for i, pipeline := range a.Pipelines {
+			redactedPipeline := *pipeline
+			if pipeline.Pipeline != nil {
+				p := *pipeline.Pipeline
+				p.AccountID = maskAccountID(p.AccountID)
+				redactedPipeline.Pipeline = &p
+			}
+			r.Pipelines[i] = &redactedPipeline
+		}
+	}
+	r.StackARN = maskARN(a.StackARN)
+	r.StackSetARN = maskARN(a.StackSetARN)
+	if redaction != RedactAccountIDsAndURIs {
+		return &r
+	}
+	if a.URI != "" {
+		r.URI = redactedValue
+	}
+	for _, env := range r.Envs {
+		if env.Endpoint != "" {
+			env.Endpoint = redactedValue
+		}
+	}
+	if a.Services != nil {
+		r.Services = make([]*ServiceSummary, len(a.Services))
+		for i, svc := range a.Services {
+			redactedSvc := *svc
+			if svc.URLs != nil {
+				redactedSvc.URLs = make(map[string]string, len(svc.URLs))
+				for env := range svc.URLs {
+					redactedSvc.URLs[env] = redactedValue
+				}
+			}
+			r.Services[i] = &redactedSvc
+		}
+	}
+	return &r
+}
+
+func redactEnvironment(env *config.Environment) *config.Environment {
+	redacted := *env
+	redacted.AccountID = maskAccountID(env.AccountID)
+	if env.AccountID != "" {
+		redacted.RegistryURL = strings.ReplaceAll(env.RegistryURL, env.AccountID, redacted.AccountID)
+	}
+	redacted.ManagerRoleARN = maskARN(env.ManagerRoleARN)
+	redacted.ExecutionRoleARN = maskARN(env.ExecutionRoleARN)
+	return &redacted
+}
+
+// maskAccountID masks all but the last 4 digits of an account ID, such as "****9012".
+func maskAccountID(id string) string {
+	if id == "" {
+		return ""
+	}
+	if len(id) <= 4 {
+		return redactedMask
+	}
+	return redactedMask + id[len(id)-4:]
+}
+
+// maskARN masks the account ID of an ARN. Values that aren't ARNs are returned as is.
+func maskARN(s string) string {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return s
+	}
+	parsed.AccountID = maskAccountID(parsed.AccountID)
+	return parsed.String()
+}
@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_Redaction(t *testing.T) {
+	newApp := func() *App {
+		return &App{
+			Name: "phonetool",
+			URI:  "example.com",
+			Envs: []*EnvSummary{
+				{Environment: &config.Environment{
+					Name:           "test",
+					Region:         "us-west-2",
+					AccountID:      "123456789012",
+					RegistryURL:    "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool",
+					ManagerRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+				}, Endpoint: "test.phonetool.example.com"},
+			},
+			Services: []*ServiceSummary{
+				{
+					Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"},
+					URLs:     map[string]string{"test": "https://test.example.com"},
+				},
+			},
+			Pipelines: []*PipelineSummary{
+				{Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool", AccountID: "123456789012"}},
+			},
+			StackARN: "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1234",
+		}
+	}
+	testCases := map[string]struct {
+		inRedaction Redaction
+
+		wantedHuman       []string
+		wantedJSON        []string
+		wantedNotInOutput []string
+	}{
+		"masks the account IDs": {
+			inRedaction: RedactAccountIDs,
+
+			wantedHuman: []string{"****9012", "alias: example.com", "https://test.example.com"},
+			wantedJSON: []string{
+				`"accountID":"****9012"`,
+				`"registryURL":"****9012.dkr.ecr.us-west-2.amazonaws.com/phonetool"`,
+				`"managerRoleARN":"arn:aws:iam::****9012:role/phonetool-test-EnvManagerRole"`,
+				`"accountId":"****9012"`,
+				`"stackARN":"arn:aws:cloudformation:us-west-2:****9012:stack/phonetool-infrastructure-roles/1234"`,
+				`"uri":"example.com"`,
+				`"endpoint":"test.phonetool.example.com"`,
+			},
+			wantedNotInOutput: []string{"123456789012"},
+		},
+		"masks the account IDs and the URIs": {
+			inRedaction: RedactAccountIDsAndURIs,
+
+			wantedHuman: []string{"****9012", "alias: [redacted]", "- test: [redacted]"},
+			wantedJSON: []string{
+				`"accountID":"****9012"`,
+				`"uri":"[redacted]"`,
+				`"urls":{"test":"[redacted]"}`,
+				`"endpoint":"[redacted]"`,
+			},
+			wantedNotInOutput: []string{"123456789012", "example.com"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			app := newApp()
+			opts := AppRenderOptions{Redaction: tc.inRedaction}
+
+			// WHEN
+			human := app.HumanStringWithOptions(opts)
+			data, err := app.JSONStringWithOptions(opts)
+
+			// THEN
+			require.NoError(t, err)
+			for _, wanted := range tc.wantedHuman {
+				require.Contains(t, human, wanted)
+			}
+			for _, wanted := range tc.wantedJSON {
+				require.Contains(t, data, wanted)
+			}
+			for _, secret := range tc.wantedNotInOutput {
+				require.NotContains(t, human, secret)
+				require.NotContains(t, data, secret)
+			}
+			var redacted, complete map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(data), &redacted))
+			completeData, err := newApp().JSONString()
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal([]byte(completeData), &complete))
+			for key := range complete {
+				require.Contains(t, redacted, key, "expected the redacted JSON to keep the key %s", key)
+			}
+			require.Equal(t, newApp(), app, "expected the App to be left as is")
+		})
+	}
+}
+
+func TestMaskAccountID(t *testing.T) {
+	require.Equal(t, "", maskAccountID(""))
+	require.Equal(t, "****", maskAccountID("1234"))
+	require.Equal(t, "****9012", maskAccountID("123456789012"))
+}
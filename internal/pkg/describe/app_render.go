@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+)
+
+// AppRenderOptions selects how an App is rendered by its output formats. The App itself only holds the description,
+// so that the same App can be rendered in several ways. The zero value renders every section with the default layout.
+type AppRenderOptions struct {
+	HumanStringOptions // Layout of the tables in human readable format.
+
+	Sections            []AppSection      // Sections of the human readable format, all of them if empty.
+	GroupServicesByType bool              // Render the Services section with one group of services per type.
+	EnvTagColumns       []string          // Keys of the environment tags rendered as extra columns of the Environments section.
+	SortEnvsByAge       bool              // Sort the environments from the oldest to the newest instead of by name.
+	ServiceCoverage     bool              // Render a Coverage column of the number of environments each service is deployed to.
+	HeaderTranslations  map[string]string // Translations of the headers of the human readable format keyed by their English text.
+	EnvRegion           string            // Only render the environments in this region and their deployments, if set.
+	Redaction           Redaction         // Sensitive values masked in all output formats, none by default.
+	OutputProfile       OutputProfile     // Casing of the keys of JSONStringWithOptions and JSONStringIndent, defaults to the JSON tags.
+	Compact             bool              // Drop the empty sections from JSONStringWithOptions and JSONStringIndent.
+}
+
+// appView is an App prepared to be rendered with a set of AppRenderOptions.
+type appView struct {
+	*App
+	AppRenderOptions
+}
+
+// view returns the App sorted, redacted and filtered according to opts. The App is left as is.
+func (a *App) view(opts AppRenderOptions) *appView {
+	app := a.sorted(opts.SortEnvsByAge).redacted(opts.Redaction)
+	if opts.EnvRegion != "" {
+		app.Envs = app.envSummariesInRegion(opts.EnvRegion)
+		if app.Deployments != nil {
+			deployments := make(map[string][]string)
+			for _, env := range app.Envs {
+				if svcs, ok := app.Deployments[env.Name]; ok {
+					deployments[env.Name] = svcs
+				}
+			}
+			app.Deployments = deployments
+		}
+	}
+	return &appView{
+		App:              app,
+		AppRenderOptions: opts,
+	}
+}
+
+// marshalData returns the JSON encoding of the App of the view with the keys of the JSON tags.
+func (a *appView) marshalData() ([]byte, error) {
+	type app App // Alias type to avoid an infinite recursion.
+	data := *a.App
+	if data.Envs == nil {
+		data.Envs = []*EnvSummary{}
+	}
+	if data.Services == nil {
+		data.Services = []*ServiceSummary{}
+	}
+	if data.Pipelines == nil {
+		data.Pipelines = []*PipelineSummary{}
+	}
+	return json.Marshal(struct {
+		SchemaVersion string `json:"schemaVersion"`
+		*app
+	}{
+		SchemaVersion: AppJSONSchemaVersion,
+		app:           (*app)(&data),
+	})
+}
+
+// RenderOptions returns the AppRenderOptions selected by the options of the describer,
+// to render the descriptions returned by Describe.
+func (d *AppDescriber) RenderOptions() AppRenderOptions {
+	return AppRenderOptions{
+		GroupServicesByType: d.groupServicesByType,
+		EnvTagColumns:       d.envTagColumns,
+		SortEnvsByAge:       d.sortEnvsByAge,
+		ServiceCoverage:     d.includeCoverage,
+		HeaderTranslations:  d.headerTranslations,
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Keys of the OpenTelemetry resource attributes of an App.
+const (
+	AttrAppName          = "app.name"               // Name of the application.
+	AttrAppURI           = "app.uri"                // Domain name of the application, omitted if it doesn't have one.
+	AttrAppEnvCount      = "app.environments.count" // Number of environments of the application.
+	AttrAppEnvNames      = "app.environments.names" // Comma separated names of the environments, sorted by name.
+	AttrAppRegions       = "app.regions"            // Comma separated regions of the environments, sorted and without duplicates.
+	AttrAppSvcCount      = "app.services.count"     // Number of services of the application.
+	AttrAppPipelineCount = "app.pipelines.count"    // Number of pipelines of the application.
+	AttrAppStackARN      = "app.stack.arn"          // ARN of the app CloudFormation stack, omitted if unknown.
+)
+
+// ResourceAttributes returns the description of the application as OpenTelemetry resource attributes so that
+// traces and metrics can be tagged with the application they belong to. The keys are the Attr* constants.
+// Attributes without a value are omitted.
+func (a *App) ResourceAttributes() map[string]string {
+	sorted := a.sorted(false)
+	var envNames []string
+	regions := make(map[string]bool)
+	for _, env := range sorted.Envs {
+		envNames = append(envNames, env.Name)
+		regions[env.Region] = true
+	}
+	var regionNames []string
+	for region := range regions {
+		if region == "" {
+			continue
+		}
+		regionNames = append(regionNames, region)
+	}
+	sort.Strings(regionNames)
+
+	attrs := map[string]string{
+		AttrAppName:          a.Name,
+		AttrAppURI:           a.URI,
+		AttrAppEnvCount:      strconv.Itoa(len(a.Envs)),
+		AttrAppEnvNames:      strings.Join(envNames, ","),
+		AttrAppRegions:       strings.Join(regionNames, ","),
+		AttrAppSvcCount:      strconv.Itoa(len(a.Services)),
+		AttrAppPipelineCount: strconv.Itoa(len(a.Pipelines)),
+		AttrAppStackARN:      a.StackARN,
+	}
+	for key, value := range attrs {
+		if value == "" {
+			delete(attrs, key)
+		}
+	}
+	return attrs
+}
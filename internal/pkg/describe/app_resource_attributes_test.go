@@ -0,0 +1,68 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_ResourceAttributes(t *testing.T) {
+	testCases := map[string]struct {
+		inApp *App
+
+		wantedAttrs map[string]string
+	}{
+		"should only have the counts of an empty application": {
+			inApp: &App{
+				Name: "phonetool",
+			},
+
+			wantedAttrs: map[string]string{
+				"app.name":               "phonetool",
+				"app.environments.count": "0",
+				"app.services.count":     "0",
+				"app.pipelines.count":    "0",
+			},
+		},
+		"should describe the application": {
+			inApp: &App{
+				Name: "phonetool",
+				URI:  "example.com",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test", Region: "us-west-2"}},
+					{Environment: &config.Environment{Name: "prod", Region: "us-east-1"}},
+					{Environment: &config.Environment{Name: "perf", Region: "us-west-2"}},
+				},
+				Services: []*ServiceSummary{
+					{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+				},
+				Pipelines: []*PipelineSummary{
+					{Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool"}},
+				},
+				StackARN: "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1234",
+			},
+
+			wantedAttrs: map[string]string{
+				"app.name":               "phonetool",
+				"app.uri":                "example.com",
+				"app.environments.count": "3",
+				"app.environments.names": "perf,prod,test",
+				"app.regions":            "us-east-1,us-west-2",
+				"app.services.count":     "1",
+				"app.pipelines.count":    "1",
+				"app.stack.arn":          "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1234",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedAttrs, tc.inApp.ResourceAttributes())
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"gopkg.in/yaml.v3"
+)
+
+// Rollout strategies of the ECS service of a service stack.
+const (
+	RolloutCircuitBreaker = "circuit-breaker" // Rolling update that rolls back when tasks fail to start.
+	RolloutRolling        = "rolling"
+	RolloutRecreate       = "recreate" // All tasks are stopped before new ones are started.
+)
+
+const ecsServiceResourceType = "AWS::ECS::Service"
+
+// serviceRollouts returns the rollout strategy of a service in each environment that it is deployed to.
+// Environments whose service stack doesn't configure the deployment of its ECS service are omitted.
+func (d *AppDescriber) serviceRollouts(svc string, envs []*config.Environment, deployments map[string][]string) (map[string]string, error) {
+	if d.newEnvCFN == nil || deployments == nil {
+		return nil, nil
+	}
+	var rollouts map[string]string
+	for _, env := range envs {
+		if !containsString(deployments[env.Name], svc) {
+			continue
+		}
+		client, err := d.envCFN(env)
+		if err != nil {
+			return nil, fmt.Errorf("new CloudFormation client for environment %s: %w", env.Name, err)
+		}
+		svcStackName := d.svcStackName(env.Name, svc)
+		done := d.traceCall("cloudformation.TemplateBody", svcStackName)
+		body, err := client.TemplateBody(svcStackName)
+		done()
+		if err != nil {
+			return nil, fmt.Errorf("get template of stack %s: %w", svcStackName, err)
+		}
+		rollout, err := templateRollout(body)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal template of stack %s: %w", svcStackName, err)
+		}
+		if rollout == "" {
+			continue
+		}
+		if rollouts == nil {
+			rollouts = make(map[string]string)
+		}
+		rollouts[env.Name] = rollout
+	}
+	return rollouts, nil
+}
+
+// templateRollout returns the rollout strategy from the DeploymentConfiguration of the ECS service of a template,
+// or an empty string if the template doesn't have an ECS service or its deployment isn't configured.
+func templateRollout(body string) (string, error) {
+	tpl := struct {
+		Resources map[string]struct {
+			Type       string `yaml:"Type"`
+			Properties struct {
+				DeploymentConfiguration *struct {
+					DeploymentCircuitBreaker *struct {
+						Enable bool `yaml:"Enable"`
+					} `yaml:"DeploymentCircuitBreaker"`
+					MinimumHealthyPercent *int `yaml:"MinimumHealthyPercent"`
+				} `yaml:"DeploymentConfiguration"`
+			} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}{}
+	if err := yaml.Unmarshal([]byte(body), &tpl); err != nil {
+		return "", err
+	}
+	for _, resource := range tpl.Resources {
+		if resource.Type != ecsServiceResourceType {
+			continue
+		}
+		config := resource.Properties.DeploymentConfiguration
+		switch {
+		case config == nil:
+			return "", nil
+		case config.DeploymentCircuitBreaker != nil && config.DeploymentCircuitBreaker.Enable:
+			return RolloutCircuitBreaker, nil
+		case config.MinimumHealthyPercent != nil && *config.MinimumHealthyPercent == 0:
+			return RolloutRecreate, nil
+		default:
+			return RolloutRolling, nil
+		}
+	}
+	return "", nil
+}
+
+// rollout returns the distinct rollout strategies of the service across environments, or a dash if they are unknown.
+func (s *ServiceSummary) rollout() string {
+	seen := make(map[string]bool)
+	var rollouts []string
+	for _, rollout := range s.Rollouts {
+		if seen[rollout] {
+			continue
+		}
+		seen[rollout] = true
+		rollouts = append(rollouts, rollout)
+	}
+	sort.Strings(rollouts)
+	return valueOrDash(strings.Join(rollouts, ", "))
+}
+
+// hasRollouts returns true if the rollout strategy of any service is known.
+func (a *App) hasRollouts() bool {
+	for _, svc := range a.Services {
+		if len(svc.Rollouts) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,235 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateRollout(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+
+		wanted string
+	}{
+		"circuit breaker": {
+			inTemplate: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      Cluster: !ImportValue phonetool-test-ClusterId
+      DeploymentConfiguration:
+        DeploymentCircuitBreaker:
+          Enable: true
+          Rollback: true
+        MinimumHealthyPercent: 100
+        MaximumPercent: 200`,
+
+			wanted: RolloutCircuitBreaker,
+		},
+		"rolling": {
+			inTemplate: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DeploymentConfiguration:
+        MinimumHealthyPercent: 100
+        MaximumPercent: 200`,
+
+			wanted: RolloutRolling,
+		},
+		"recreate": {
+			inTemplate: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DeploymentConfiguration:
+        DeploymentCircuitBreaker:
+          Enable: false
+        MinimumHealthyPercent: 0
+        MaximumPercent: 100`,
+
+			wanted: RolloutRecreate,
+		},
+		"unknown without a deployment configuration": {
+			inTemplate: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1`,
+
+			wanted: "",
+		},
+		"unknown without an ECS service": {
+			inTemplate: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue`,
+
+			wanted: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual, err := templateRollout(tc.inTemplate)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
+
+func TestAppDescriber_Describe_ServiceRollouts(t *testing.T) {
+	testError := errors.New("some error")
+	const circuitBreakerTemplate = `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DeploymentConfiguration:
+        DeploymentCircuitBreaker:
+          Enable: true`
+	testCases := map[string]struct {
+		withoutDeployStore bool
+		setupEnvCFN        func(m *mocks.MockstackDescriber)
+
+		wantedServices []*ServiceSummary
+		wantedError    error
+	}{
+		"reads the rollout of each deployed service": {
+			setupEnvCFN: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().TemplateBody("phonetool-test-frontend").Return(circuitBreakerTemplate, nil)
+				m.EXPECT().TemplateBody("phonetool-prod-frontend").Return(`
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DeploymentConfiguration:
+        MinimumHealthyPercent: 100`, nil)
+				m.EXPECT().TemplateBody("phonetool-test-worker").Return(`
+Resources:
+  Service:
+    Type: AWS::ECS::Service`, nil)
+			},
+
+			wantedServices: []*ServiceSummary{
+				{
+					Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"},
+					Rollouts: map[string]string{"test": RolloutCircuitBreaker, "prod": RolloutRolling},
+				},
+				{Workload: &config.Workload{Name: "worker", Type: "Backend Service"}},
+			},
+		},
+		"skips the rollouts without a deploy store": {
+			withoutDeployStore: true,
+			setupEnvCFN:        func(m *mocks.MockstackDescriber) {},
+
+			wantedServices: []*ServiceSummary{
+				{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+				{Workload: &config.Workload{Name: "worker", Type: "Backend Service"}},
+			},
+		},
+		"returns error if fail to get the template": {
+			setupEnvCFN: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().TemplateBody("phonetool-test-frontend").Return("", testError)
+				// The other services are still enriched concurrently.
+				m.EXPECT().TemplateBody("phonetool-test-worker").Return("", nil).AnyTimes()
+			},
+
+			wantedError: fmt.Errorf("get template of stack phonetool-test-frontend: %w", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+				{Name: "test"},
+				{Name: "prod"},
+			}, nil)
+			configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+				{Name: "frontend", Type: "Load Balanced Web Service"},
+				{Name: "worker", Type: "Backend Service"},
+			}, nil)
+			appCFN := mocks.NewMockcfn(ctrl)
+			appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			envCFN := mocks.NewMockstackDescriber(ctrl)
+			tc.setupEnvCFN(envCFN)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				cfn:         appCFN,
+				newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+					return envCFN, nil
+				},
+
+				includeRollouts: true,
+			}
+			if !tc.withoutDeployStore {
+				deployStore := mocks.NewMockDeployedServicesLister(ctrl)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend", "worker"}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "prod").Return([]string{"frontend"}, nil)
+				d.deployStore = deployStore
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedServices, actual.Services)
+			}
+		})
+	}
+}
+
+func TestApp_HumanString_Rollouts(t *testing.T) {
+	// GIVEN
+	app := &App{
+		Name: "phonetool",
+		Services: []*ServiceSummary{
+			{
+				Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"},
+				Rollouts: map[string]string{"test": RolloutCircuitBreaker, "prod": RolloutRolling, "staging": RolloutRolling},
+			},
+			{Workload: &config.Workload{Name: "worker", Type: "Backend Service"}},
+		},
+	}
+
+	// WHEN
+	actual := app.HumanStringSections(SectionServices)
+
+	// THEN
+	require.Equal(t, `Services (2)
+
+  Name              Type                       Rollout
+  ----              ----                       -------
+  frontend          Load Balanced Web Service  circuit-breaker, rolling
+  worker            Backend Service            -
+`, actual)
+	require.NotContains(t, (&App{Services: []*ServiceSummary{{Workload: &config.Workload{Name: "worker"}}}}).HumanString(), "Rollout",
+		"expected no Rollout column without any known rollout")
+}
@@ -0,0 +1,49 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	jsonContentType        = "application/json"
+	s3SnapshotTimeLayout   = "20060102T150405Z"
+	s3SnapshotKeyExtension = ".json"
+)
+
+// WriteToS3 uploads the JSON description of the application, as returned by JSONStringWithOptions
+// with the RenderOptions of the describer, to the object key of bucket. The object is uploaded with the credentials of the describer's session. If key is empty or ends with a "/", then it is a prefix
+// and the object is named after the application and the time it was described at, such as "snapshots/phonetool-20210102T150405Z.json",
+// so that scheduled jobs can keep one snapshot per run.
+func (d *AppDescriber) WriteToS3(bucket, key string) error {
+	if d.s3Svc == nil {
+		return fmt.Errorf("write description of application %s to s3: the describer has no S3 client", d.app)
+	}
+	app, err := d.Describe()
+	if err != nil {
+		return err
+	}
+	data, err := app.JSONStringWithOptions(d.RenderOptions())
+	if err != nil {
+		return err
+	}
+	key = d.s3SnapshotKey(key)
+	done := d.traceCall("s3.PutObject", key)
+	_, err = d.s3Svc.PutObject(bucket, key, jsonContentType, strings.NewReader(data))
+	done()
+	if err != nil {
+		return fmt.Errorf("upload description of application %s to s3://%s/%s: %w", d.app, bucket, key, err)
+	}
+	return nil
+}
+
+// s3SnapshotKey returns key as is, or the key of a new snapshot of the application if key is a prefix ending with a "/".
+func (d *AppDescriber) s3SnapshotKey(key string) string {
+	if key != "" && !strings.HasSuffix(key, "/") {
+		return key
+	}
+	return fmt.Sprintf("%s%s-%s%s", key, d.app, d.now().UTC().Format(s3SnapshotTimeLayout), s3SnapshotKeyExtension)
+}
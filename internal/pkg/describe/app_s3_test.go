@@ -0,0 +1,106 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDescriber_WriteToS3(t *testing.T) {
+	testNow := time.Date(2021, time.April, 4, 12, 0, 0, 0, time.FixedZone("PDT", -7*60*60))
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		inKey        string
+		mockS3Err    error
+		withoutS3Svc bool
+
+		wantedKey   string
+		wantedError error
+	}{
+		"uploads the description under the key": {
+			inKey: "snapshots/phonetool.json",
+
+			wantedKey: "snapshots/phonetool.json",
+		},
+		"names the object after the application and the time if the key is a prefix": {
+			inKey: "snapshots/",
+
+			wantedKey: "snapshots/phonetool-20210404T190000Z.json",
+		},
+		"names the object after the application and the time if the key is empty": {
+			wantedKey: "phonetool-20210404T190000Z.json",
+		},
+		"returns a wrapped error if the upload fails": {
+			inKey:     "snapshots/",
+			mockS3Err: testError,
+
+			wantedKey:   "snapshots/phonetool-20210404T190000Z.json",
+			wantedError: fmt.Errorf("upload description of application phonetool to s3://inventory/snapshots/phonetool-20210404T190000Z.json: %w", testError),
+		},
+		"returns error if the describer has no S3 client": {
+			withoutS3Svc: true,
+
+			wantedError: errors.New("write description of application phonetool to s3: the describer has no S3 client"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil).AnyTimes()
+			configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil).AnyTimes()
+			configStore.EXPECT().ListServices("phonetool").Return(nil, nil).AnyTimes()
+			appCFN := mocks.NewMockcfn(ctrl)
+			appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			s3Svc := mocks.NewMockobjectUploader(ctrl)
+			var gotBody string
+			if tc.wantedKey != "" {
+				s3Svc.EXPECT().PutObject("inventory", tc.wantedKey, "application/json", gomock.Any()).
+					DoAndReturn(func(_, _, _ string, data io.Reader) (string, error) {
+						b, err := ioutil.ReadAll(data)
+						require.NoError(t, err)
+						gotBody = string(b)
+						return "https://inventory.s3.amazonaws.com/" + tc.wantedKey, tc.mockS3Err
+					})
+			}
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				cfn:         appCFN,
+				s3Svc:       s3Svc,
+				now: func() time.Time {
+					return testNow
+				},
+			}
+			if tc.withoutS3Svc {
+				d.s3Svc = nil
+			}
+
+			// WHEN
+			err := d.WriteToS3("inventory", tc.inKey)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, `{"name":"phonetool","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":"","managed":false}],"services":[],"pipelines":[],"schemaVersion":"2023-10-01"}`, gotBody)
+		})
+	}
+}
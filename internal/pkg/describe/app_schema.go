@@ -0,0 +1,135 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// AppJSONSchema returns a JSON Schema document describing the output of App.JSONString.
+// The schema is generated from the json struct tags of App so that it always matches the serialized fields.
+func AppJSONSchema() ([]byte, error) {
+	schema := jsonSchemaFor(reflect.TypeOf(App{}))
+	// The schema version is added by App.MarshalJSON rather than being a field of App.
+	schema["properties"].(map[string]interface{})["schemaVersion"] = map[string]interface{}{
+		"type":  "string",
+		"const": AppJSONSchemaVersion,
+	}
+	schema["required"] = append([]string{"schemaVersion"}, schema["required"].([]string)...)
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = "App"
+	b, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal application description schema: %w", err)
+	}
+	return append(b, '\n'), nil
+}
+
+// jsonSchemaFor returns the JSON Schema of the values of type t once encoded with encoding/json.
+// Pointers, slices and maps are encoded as null when they're nil, so their schema also accepts null.
+func jsonSchemaFor(t reflect.Type) map[string]interface{} {
+	nullable := false
+	for t.Kind() == reflect.Ptr {
+		nullable = true
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		nullable = true
+	}
+	schema := nonNullJSONSchemaFor(t)
+	if typ, ok := schema["type"]; ok && nullable {
+		schema["type"] = []interface{}{typ, "null"}
+	}
+	return schema
+}
+
+func nonNullJSONSchemaFor(t reflect.Type) map[string]interface{} {
+	if t == timeType {
+		return map[string]interface{}{
+			"type":   "string",
+			"format": "date-time",
+		}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{
+			"type":  "array",
+			"items": jsonSchemaFor(t.Elem()),
+		}
+	case reflect.Map:
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": jsonSchemaFor(t.Elem()),
+		}
+	case reflect.Struct:
+		properties := make(map[string]interface{})
+		var required []string
+		addStructFields(t, properties, &required)
+		schema := map[string]interface{}{
+			"type":       "object",
+			"properties": properties,
+		}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema
+	default:
+		// Interfaces can hold any value.
+		return map[string]interface{}{}
+	}
+}
+
+// addStructFields adds the schema of each field serialized by encoding/json to properties.
+// Fields of embedded structs are promoted to the parent as encoding/json does.
+func addStructFields(t reflect.Type, properties map[string]interface{}, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts := tag, ""
+		if idx := strings.Index(tag, ","); idx != -1 {
+			name, opts = tag[:idx], tag[idx+1:]
+		}
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				addStructFields(embedded, properties, required)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			// Unexported fields are not serialized.
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = jsonSchemaFor(field.Type)
+		if !strings.Contains(opts, "omitempty") {
+			*required = append(*required, name)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppJSONSchema(t *testing.T) {
+	// GIVEN
+	wanted, err := ioutil.ReadFile(filepath.Join("testdata", "app_schema.json"))
+	require.NoError(t, err, "unexpected error while reading testdata file")
+
+	// WHEN
+	actual, err := AppJSONSchema()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, string(wanted), string(actual))
+}
@@ -0,0 +1,64 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+
+// StackNameResolver returns the names of the CloudFormation stacks and stack set of an application.
+type StackNameResolver interface {
+	AppStack(app string) string
+	AppStackSet(app string) string
+	EnvStack(app, env string) string
+	ServiceStack(app, env, svc string) string
+}
+
+// WithStackNameResolver sets the names of the stacks and stack set that the describer reads, for applications
+// deployed with a naming scheme other than the default one of Copilot, such as a mandatory prefix.
+func WithStackNameResolver(names StackNameResolver) AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.stackNames = names
+	}
+}
+
+// defaultStackNames resolves the names that Copilot gives to the stacks of an application.
+type defaultStackNames struct{}
+
+func (defaultStackNames) AppStack(app string) string {
+	return stack.NameForAppStack(app)
+}
+
+func (defaultStackNames) AppStackSet(app string) string {
+	return stack.NameForAppStackSet(app)
+}
+
+func (defaultStackNames) EnvStack(app, env string) string {
+	return stack.NameForEnv(app, env)
+}
+
+func (defaultStackNames) ServiceStack(app, env, svc string) string {
+	return stack.NameForService(app, env, svc)
+}
+
+func (d *AppDescriber) names() StackNameResolver {
+	if d.stackNames == nil {
+		return defaultStackNames{}
+	}
+	return d.stackNames
+}
+
+func (d *AppDescriber) appStackName() string {
+	return d.names().AppStack(d.app)
+}
+
+func (d *AppDescriber) appStackSetName() string {
+	return d.names().AppStackSet(d.app)
+}
+
+func (d *AppDescriber) envStackName(env string) string {
+	return d.names().EnvStack(d.app, env)
+}
+
+func (d *AppDescriber) svcStackName(env, svc string) string {
+	return d.names().ServiceStack(d.app, env, svc)
+}
@@ -0,0 +1,52 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// prefixedStackNames prefixes the default stack names with an organization-wide prefix.
+type prefixedStackNames struct {
+	prefix string
+}
+
+func (n prefixedStackNames) AppStack(app string) string {
+	return n.prefix + defaultStackNames{}.AppStack(app)
+}
+
+func (n prefixedStackNames) AppStackSet(app string) string {
+	return n.prefix + defaultStackNames{}.AppStackSet(app)
+}
+
+func (n prefixedStackNames) EnvStack(app, env string) string {
+	return n.prefix + defaultStackNames{}.EnvStack(app, env)
+}
+
+func (n prefixedStackNames) ServiceStack(app, env, svc string) string {
+	return n.prefix + defaultStackNames{}.ServiceStack(app, env, svc)
+}
+
+func TestAppDescriber_Version_StackNameResolver(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfn(ctrl)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("acme-phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("acme-phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+	d := NewAppDescriberFromStore("phonetool", nil, m, WithStackNameResolver(prefixedStackNames{prefix: "acme-"}))
+
+	// WHEN
+	version, err := d.Version()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", version)
+	require.Equal(t, []string{"acme-phonetool-infrastructure-roles", "acme-phonetool-infrastructure", "acme-phonetool-test"}, d.StackNames("test"))
+}
@@ -0,0 +1,46 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+)
+
+// WithTagCheck makes Describe verify that the app stack has the standard copilot tags that are propagated to the
+// resources of the application, and attach a warning to the description for each tag that is missing or has an unexpected
+// value. Such tags otherwise only surface as gaps in the cost allocation reports.
+func WithTagCheck() AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.checkTags = true
+	}
+}
+
+// appStackTagWarnings returns a warning for each standard copilot tag that is missing from the tags of the app stack
+// or that doesn't have the expected value, sorted by tag key.
+func (d *AppDescriber) appStackTagWarnings(tags map[string]string) []string {
+	wanted := map[string]string{
+		deploy.AppTagKey: d.app,
+	}
+	var keys []string
+	for key := range wanted {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var warnings []string
+	for _, key := range keys {
+		value, ok := tags[key]
+		switch {
+		case !ok:
+			warnings = append(warnings, fmt.Sprintf("app stack %s is missing the tag %s, its resources may be missing from the cost allocation reports of application %s",
+				d.appStackName(), key, d.app))
+		case value != wanted[key]:
+			warnings = append(warnings, fmt.Sprintf("app stack %s has the tag %s=%s instead of %s=%s, its resources may be attributed to the wrong application in the cost allocation reports",
+				d.appStackName(), key, value, key, wanted[key]))
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,104 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	fatihcolor "github.com/fatih/color"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDescriber_Describe_TagCheck(t *testing.T) {
+	testCases := map[string]struct {
+		inCheckTags bool
+		inTags      []*awscfn.Tag
+
+		wantedWarnings []string
+	}{
+		"doesn't check the tags by default": {
+			wantedWarnings: nil,
+		},
+		"has no warnings if the standard tags are present": {
+			inCheckTags: true,
+			inTags: []*awscfn.Tag{
+				{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+				{Key: aws.String("team"), Value: aws.String("payments")},
+			},
+
+			wantedWarnings: nil,
+		},
+		"warns about a missing tag": {
+			inCheckTags: true,
+			inTags: []*awscfn.Tag{
+				{Key: aws.String("team"), Value: aws.String("payments")},
+			},
+
+			wantedWarnings: []string{
+				"app stack phonetool-infrastructure-roles is missing the tag copilot-application, its resources may be missing from the cost allocation reports of application phonetool",
+			},
+		},
+		"warns about a tag with an unexpected value": {
+			inCheckTags: true,
+			inTags: []*awscfn.Tag{
+				{Key: aws.String("copilot-application"), Value: aws.String("phonetool-old")},
+			},
+
+			wantedWarnings: []string{
+				"app stack phonetool-infrastructure-roles has the tag copilot-application=phonetool-old instead of copilot-application=phonetool, its resources may be attributed to the wrong application in the cost allocation reports",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+			appCFN := mocks.NewMockcfn(ctrl)
+			appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{Tags: tc.inTags}, nil)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				cfn:         appCFN,
+
+				checkTags: tc.inCheckTags,
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWarnings, actual.Warnings)
+		})
+	}
+}
+
+func TestApp_HumanString_WarningsHighlighted(t *testing.T) {
+	defer func(noColor bool) {
+		fatihcolor.NoColor = noColor
+	}(fatihcolor.NoColor)
+	fatihcolor.NoColor = false
+	app := &App{
+		Name:     "phonetool",
+		Warnings: []string{"something is off"},
+	}
+
+	// WHEN
+	actual := app.HumanStringSections(SectionWarnings)
+
+	// THEN
+	require.Contains(t, actual, fatihcolor.New(fatihcolor.FgYellow).Add(fatihcolor.Bold).Sprint("Warnings\n\n"), "expected the warnings header to be highlighted in yellow")
+}
@@ -4,13 +4,30 @@
 package describe
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/dustin/go-humanize"
+	fatihcolor "github.com/fatih/color"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/semver"
 )
 
 func TestAppDescriber_Version(t *testing.T) {
@@ -23,7 +40,8 @@ func TestAppDescriber_Version(t *testing.T) {
 		"should return error if fail to get metadata": {
 			given: func(ctrl *gomock.Controller) *AppDescriber {
 				m := mocks.NewMockcfn(ctrl)
-				m.EXPECT().Metadata(gomock.Any()).Return("", errors.New("some error"))
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return("", errors.New("some error"))
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
 				return &AppDescriber{
 					app: "phonetool",
 					cfn: m,
@@ -34,8 +52,8 @@ func TestAppDescriber_Version(t *testing.T) {
 		"success": {
 			given: func(ctrl *gomock.Controller) *AppDescriber {
 				m := mocks.NewMockcfn(ctrl)
-				m.EXPECT().Metadata(gomock.Any()).Return(`{"TemplateVersion":"v1.2.0"}`, nil)
-				m.EXPECT().Metadata(gomock.Any()).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.2.0"}`, nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
 				return &AppDescriber{
 					app: "phonetool",
 					cfn: m,
@@ -47,8 +65,8 @@ func TestAppDescriber_Version(t *testing.T) {
 		"success with legacy template": {
 			given: func(ctrl *gomock.Controller) *AppDescriber {
 				m := mocks.NewMockcfn(ctrl)
-				m.EXPECT().Metadata(gomock.Any()).Return("", nil)
-				m.EXPECT().Metadata(gomock.Any()).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return("", nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
 				return &AppDescriber{
 					app: "phonetool",
 					cfn: m,
@@ -57,6 +75,19 @@ func TestAppDescriber_Version(t *testing.T) {
 
 			wantedVersion: "v0.0.0",
 		},
+		"success with a stack set that doesn't exist yet": {
+			given: func(ctrl *gomock.Controller) *AppDescriber {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return("", &cloudformation.ErrStackSetNotFound{})
+				return &AppDescriber{
+					app: "phonetool",
+					cfn: m,
+				}
+			},
+
+			wantedVersion: "v1.0.0",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -79,3 +110,3488 @@ func TestAppDescriber_Version(t *testing.T) {
 		})
 	}
 }
+
+func TestAppDescriber_Version_AppStackNotFound(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfn(ctrl)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return("", &cloudformation.ErrStackNotFound{})
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+	d := &AppDescriber{
+		app: "phonetool",
+		cfn: m,
+	}
+
+	// WHEN
+	_, err := d.Version()
+
+	// THEN
+	require.True(t, errors.Is(err, ErrAppStackNotFound))
+	var notFound *cloudformation.ErrStackNotFound
+	require.True(t, errors.As(err, &notFound), "the underlying CloudFormation error should be preserved")
+}
+
+func TestAppDescriber_Version_MetadataError(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		mockStackErr    error
+		mockStackSetErr error
+
+		wantedStackName  string
+		wantedIsStackSet bool
+		wantedErr        string
+	}{
+		"names the app stack": {
+			mockStackErr: testError,
+
+			wantedStackName: "phonetool-infrastructure-roles",
+			wantedErr:       "get metadata for app stack phonetool-infrastructure-roles: some error",
+		},
+		"names the app stack set": {
+			mockStackSetErr: testError,
+
+			wantedStackName:  "phonetool-infrastructure",
+			wantedIsStackSet: true,
+			wantedErr:        "get metadata for app stack set phonetool-infrastructure: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfn(ctrl)
+			m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.0.0"}`, tc.mockStackErr).AnyTimes()
+			m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.0.0"}`, tc.mockStackSetErr).AnyTimes()
+			d := &AppDescriber{
+				app: "phonetool",
+				cfn: m,
+			}
+
+			// WHEN
+			_, err := d.Version()
+
+			// THEN
+			require.EqualError(t, err, tc.wantedErr)
+			var metadataErr *MetadataError
+			require.True(t, errors.As(err, &metadataErr))
+			require.Equal(t, tc.wantedStackName, metadataErr.StackName)
+			require.Equal(t, tc.wantedIsStackSet, metadataErr.IsStackSet)
+			require.True(t, errors.Is(err, testError), "the underlying CloudFormation error should be preserved")
+		})
+	}
+}
+
+func TestAppDescriber_Metadata(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockcfn)
+
+		wantedMetadata map[string]interface{}
+		wantedError    error
+	}{
+		"returns the decoded metadata with custom keys": {
+			setupMocks: func(m *mocks.Mockcfn) {
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.0.0","Features":{"canary":true}}`, nil)
+			},
+
+			wantedMetadata: map[string]interface{}{
+				"TemplateVersion": "v1.0.0",
+				"Features": map[string]interface{}{
+					"canary": true,
+				},
+			},
+		},
+		"returns a nil map if the template has no metadata": {
+			setupMocks: func(m *mocks.Mockcfn) {
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return("", nil)
+			},
+		},
+		"returns error if fail to get the metadata": {
+			setupMocks: func(m *mocks.Mockcfn) {
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return("", testError)
+			},
+
+			wantedError: fmt.Errorf("get metadata for app stack phonetool-infrastructure-roles: %w", testError),
+		},
+		"returns error if the metadata is malformed": {
+			setupMocks: func(m *mocks.Mockcfn) {
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return("[", nil)
+			},
+
+			wantedError: errors.New("unmarshal Metadata property for app stack phonetool-infrastructure-roles: yaml: line 1: did not find expected node content"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfn(ctrl)
+			tc.setupMocks(m)
+			d := &AppDescriber{
+				app: "phonetool",
+				cfn: m,
+			}
+
+			// WHEN
+			actual, err := d.Metadata()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedMetadata, actual)
+			}
+		})
+	}
+}
+
+func TestAppDescriber_Metadata_SharedWithVersion(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfn(ctrl)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.0.0","Owner":"payments"}`, nil).Times(1)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.0.0"}`, nil).Times(1)
+	d := &AppDescriber{
+		app: "phonetool",
+		cfn: m,
+	}
+
+	// WHEN
+	metadata, metadataErr := d.Metadata()
+	version, versionErr := d.Version()
+
+	// THEN
+	require.NoError(t, metadataErr)
+	require.Equal(t, "payments", metadata["Owner"])
+	require.NoError(t, versionErr)
+	require.Equal(t, "v1.0.0", version)
+}
+
+func TestAppDescriber_VersionInfo(t *testing.T) {
+	testCases := map[string]struct {
+		given func(ctrl *gomock.Controller) *AppDescriber
+
+		wantedInfo *AppVersionInfo
+		wantedErr  error
+	}{
+		"should return error if fail to get metadata for app stack set": {
+			given: func(ctrl *gomock.Controller) *AppDescriber {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.2.0"}`, nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return("", errors.New("some error"))
+				return &AppDescriber{
+					app: "phonetool",
+					cfn: m,
+				}
+			},
+			wantedErr: fmt.Errorf("get metadata for app stack set phonetool-infrastructure: some error"),
+		},
+		"success": {
+			given: func(ctrl *gomock.Controller) *AppDescriber {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.2.0"}`, nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+				return &AppDescriber{
+					app: "phonetool",
+					cfn: m,
+				}
+			},
+
+			wantedInfo: &AppVersionInfo{
+				StackVersion:    "v1.2.0",
+				StackSetVersion: "v1.0.0",
+				MinVersion:      "v1.0.0",
+				Warning:         "app stack phonetool-infrastructure-roles is on template version v1.2.0 but app stack set phonetool-infrastructure is on template version v1.0.0, re-run the upgrade of application phonetool",
+			},
+		},
+		"success without divergence": {
+			given: func(ctrl *gomock.Controller) *AppDescriber {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.2.0"}`, nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.2.0"}`, nil)
+				return &AppDescriber{
+					app: "phonetool",
+					cfn: m,
+				}
+			},
+
+			wantedInfo: &AppVersionInfo{
+				StackVersion:    "v1.2.0",
+				StackSetVersion: "v1.2.0",
+				MinVersion:      "v1.2.0",
+			},
+		},
+		"success with legacy stack set template": {
+			given: func(ctrl *gomock.Controller) *AppDescriber {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return("", nil)
+				return &AppDescriber{
+					app: "phonetool",
+					cfn: m,
+				}
+			},
+
+			wantedInfo: &AppVersionInfo{
+				StackVersion:    "v1.0.0",
+				StackSetVersion: "v0.0.0",
+				MinVersion:      "v0.0.0",
+				IsLegacy:        true,
+				Warning:         "app stack phonetool-infrastructure-roles is on template version v1.0.0 but app stack set phonetool-infrastructure is on template version v0.0.0, re-run the upgrade of application phonetool",
+			},
+		},
+		"success with a stack set that doesn't exist yet": {
+			given: func(ctrl *gomock.Controller) *AppDescriber {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return("", &cloudformation.ErrStackSetNotFound{})
+				return &AppDescriber{
+					app: "phonetool",
+					cfn: m,
+				}
+			},
+
+			wantedInfo: &AppVersionInfo{
+				StackVersion:     "v1.0.0",
+				StackSetNotFound: true,
+				MinVersion:       "v1.0.0",
+			},
+		},
+		"success with a legacy stack and a stack set that doesn't exist yet": {
+			given: func(ctrl *gomock.Controller) *AppDescriber {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return("", nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return("", &cloudformation.ErrStackSetNotFound{})
+				return &AppDescriber{
+					app: "phonetool",
+					cfn: m,
+				}
+			},
+
+			wantedInfo: &AppVersionInfo{
+				StackVersion:     "v0.0.0",
+				StackSetNotFound: true,
+				MinVersion:       "v0.0.0",
+				IsLegacy:         true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d := tc.given(ctrl)
+
+			// WHEN
+			actual, err := d.VersionInfo()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedInfo, actual)
+			}
+		})
+	}
+}
+
+func TestMinAppTemplateVersion(t *testing.T) {
+	testCases := map[string]struct {
+		a         string
+		b         string
+		inCompare VersionComparator
+
+		wanted string
+	}{
+		"both legacy": {
+			a: deploy.LegacyAppTemplateVersion,
+			b: deploy.LegacyAppTemplateVersion,
+
+			wanted: deploy.LegacyAppTemplateVersion,
+		},
+		"legacy and versioned": {
+			a: deploy.LegacyAppTemplateVersion,
+			b: "v1.2.0",
+
+			wanted: deploy.LegacyAppTemplateVersion,
+		},
+		"versioned and legacy": {
+			a: "v1.2.0",
+			b: deploy.LegacyAppTemplateVersion,
+
+			wanted: deploy.LegacyAppTemplateVersion,
+		},
+		"both versioned": {
+			a: "v1.0.0",
+			b: "v1.2.0",
+
+			wanted: "v1.0.0",
+		},
+		"pre-release is older than its release by default": {
+			a: "v1.2.0",
+			b: "v1.2.0-preview",
+
+			wanted: "v1.2.0-preview",
+		},
+		"pre-releases are ordered by their identifiers by default": {
+			a: "v1.2.0-preview.2",
+			b: "v1.2.0-preview.10",
+
+			wanted: "v1.2.0-preview.2",
+		},
+		"custom comparator": {
+			a:         "v1.2.0-preview",
+			b:         "v1.2.0",
+			inCompare: ignorePreRelease,
+
+			wanted: "v1.2.0-preview",
+		},
+		"custom comparator keeps the first of equal versions": {
+			a:         "v1.2.0",
+			b:         "v1.2.0-preview",
+			inCompare: ignorePreRelease,
+
+			wanted: "v1.2.0",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, minAppTemplateVersion(tc.a, tc.b, tc.inCompare))
+		})
+	}
+}
+
+// ignorePreRelease compares versions without their pre-release suffix, so that a preview is considered the same version as its release.
+func ignorePreRelease(a, b string) int {
+	return semver.Compare(strings.SplitN(a, "-", 2)[0], strings.SplitN(b, "-", 2)[0])
+}
+
+func TestAppDescriber_IsVersionAheadOf_VersionComparator(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfn(ctrl)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.2.0-preview"}`, nil).AnyTimes()
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.2.0-preview"}`, nil).AnyTimes()
+	defaultDescriber := &AppDescriber{app: "phonetool", cfn: m}
+	customDescriber := &AppDescriber{app: "phonetool", cfn: m}
+	WithVersionComparator(func(a, b string) int {
+		// Treat previews as newer than their release.
+		if ignorePreRelease(a, b) == 0 && a != b {
+			if strings.Contains(a, "-") {
+				return 1
+			}
+			return -1
+		}
+		return semver.Compare(a, b)
+	})(customDescriber)
+
+	// WHEN
+	defaultAhead, defaultErr := defaultDescriber.IsVersionAheadOf("v1.2.0")
+	customAhead, customErr := customDescriber.IsVersionAheadOf("v1.2.0")
+
+	// THEN
+	require.NoError(t, defaultErr)
+	require.False(t, defaultAhead, "a preview is older than its release with semver.Compare")
+	require.NoError(t, customErr)
+	require.True(t, customAhead)
+}
+
+func TestAppDescriber_IsVersionAheadOf_NonSemverComparator(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfn(ctrl)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"2021.09"}`, nil)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"2021.09"}`, nil)
+	d := &AppDescriber{app: "phonetool", cfn: m}
+	WithVersionComparator(strings.Compare)(d)
+
+	// WHEN
+	ahead, err := d.IsVersionAheadOf("2021.06")
+
+	// THEN
+	require.NoError(t, err, "expected the comparator to accept versions that aren't semantic versions")
+	require.True(t, ahead)
+}
+
+func TestAppDescriber_IsVersionAheadOf(t *testing.T) {
+	testCases := map[string]struct {
+		inCLIMax          string
+		mockStackMetadata string
+
+		wanted    bool
+		wantedErr error
+	}{
+		"should return error if the max version is not a semantic version": {
+			inCLIMax: "latest",
+
+			wantedErr: errors.New("version latest is not a valid semantic version"),
+		},
+		"should not be ahead with a legacy template": {
+			inCLIMax:          "v1.0.0",
+			mockStackMetadata: "",
+
+			wanted: false,
+		},
+		"should not be ahead with the same version": {
+			inCLIMax:          "v1.0.0",
+			mockStackMetadata: `{"TemplateVersion":"v1.0.0"}`,
+
+			wanted: false,
+		},
+		"should be ahead with a newer version": {
+			inCLIMax:          "v1.0.0",
+			mockStackMetadata: `{"TemplateVersion":"v1.1.0"}`,
+
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfn(ctrl)
+			if tc.wantedErr == nil {
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(tc.mockStackMetadata, nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(tc.mockStackMetadata, nil)
+			}
+			d := &AppDescriber{
+				app: "phonetool",
+				cfn: m,
+			}
+
+			// WHEN
+			actual, err := d.IsVersionAheadOf(tc.inCLIMax)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
+
+// concurrentMetadataCFN is a fake cfn client that blocks each MetadataWithContext call until all expected calls have started.
+type concurrentMetadataCFN struct {
+	cfn
+
+	started sync.WaitGroup
+	mu      sync.Mutex
+	calls   []string
+}
+
+func (c *concurrentMetadataCFN) MetadataWithContext(_ context.Context, opt cloudformation.MetadataOpts) (string, error) {
+	c.record("start")
+	c.started.Done()
+	defer c.record("end")
+
+	allStarted := make(chan struct{})
+	go func() {
+		c.started.Wait()
+		close(allStarted)
+	}()
+	select {
+	case <-allStarted:
+		return `{"TemplateVersion":"v1.0.0"}`, nil
+	case <-time.After(time.Second):
+		return "", errors.New("metadata calls were not made concurrently")
+	}
+}
+
+func (c *concurrentMetadataCFN) record(event string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, event)
+}
+
+func TestAppDescriber_VersionInfo_Concurrent(t *testing.T) {
+	// GIVEN
+	fake := &concurrentMetadataCFN{}
+	fake.started.Add(2)
+	d := &AppDescriber{
+		app: "phonetool",
+		cfn: fake,
+	}
+
+	// WHEN
+	info, err := d.VersionInfo()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", info.MinVersion)
+	require.Equal(t, []string{"start", "start", "end", "end"}, fake.calls)
+}
+
+// flakyMetadataCFN is a fake cfn client whose MetadataWithContext calls for the stack named flakyStack
+// fail with err a number of times before succeeding.
+type flakyMetadataCFN struct {
+	cfn
+
+	flakyStack string
+	err        error
+	failures   int
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (c *flakyMetadataCFN) MetadataWithContext(_ context.Context, opt cloudformation.MetadataOpts) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	name := aws.StringValue(opt.StackName) + aws.StringValue(opt.StackSetName)
+	if c.calls == nil {
+		c.calls = make(map[string]int)
+	}
+	c.calls[name]++
+	if name == c.flakyStack && c.calls[name] <= c.failures {
+		return "", fmt.Errorf("get template summary: %w", c.err)
+	}
+	return `{"TemplateVersion":"v1.0.0"}`, nil
+}
+
+func TestAppDescriber_Version_Throttled(t *testing.T) {
+	// GIVEN
+	fake := &flakyMetadataCFN{
+		flakyStack: "phonetool-infrastructure-roles",
+		err:        awserr.New("Throttling", "Rate exceeded", nil),
+		failures:   1,
+	}
+	d := &AppDescriber{
+		app: "phonetool",
+		cfn: fake,
+	}
+
+	// WHEN
+	_, err := d.Version()
+
+	// THEN
+	require.EqualError(t, err, "get metadata for app stack phonetool-infrastructure-roles: get template summary: Throttling: Rate exceeded")
+	require.Equal(t, 1, fake.calls["phonetool-infrastructure-roles"], "expected throttled requests to be left to the retryer of the client")
+}
+
+func TestAppDescriber_Refresh(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfn(ctrl)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.1.0"}`, nil)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.1.0"}`, nil)
+	d := &AppDescriber{
+		app: "phonetool",
+		cfn: m,
+	}
+
+	// WHEN
+	first, err := d.Version()
+	require.NoError(t, err)
+	cached, err := d.Version()
+	require.NoError(t, err)
+	d.Refresh()
+	refreshed, err := d.Version()
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, "v1.0.0", first)
+	require.Equal(t, "v1.0.0", cached)
+	require.Equal(t, "v1.1.0", refreshed)
+}
+
+func TestAppDescriber_VersionAt(t *testing.T) {
+	testCases := map[string]struct {
+		given func(ctrl *gomock.Controller) cfn
+
+		wantedVersion string
+		wantedErr     error
+	}{
+		"should return deploy.LegacyAppTemplateVersion if the template has no Metadata": {
+			given: func(ctrl *gomock.Controller) cfn {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().TemplateBodyFromChangeSet("cs-1", "phonetool-infrastructure-roles").Return(`Resources: {}`, nil)
+				return m
+			},
+			wantedVersion: deploy.LegacyAppTemplateVersion,
+		},
+		"should return deploy.LegacyAppTemplateVersion if the Metadata has no TemplateVersion": {
+			given: func(ctrl *gomock.Controller) cfn {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().TemplateBodyFromChangeSet("cs-1", "phonetool-infrastructure-roles").Return(`Metadata:
+  Services: []
+Resources: {}`, nil)
+				return m
+			},
+			wantedVersion: deploy.LegacyAppTemplateVersion,
+		},
+		"should read the TemplateVersion from the template of the change set": {
+			given: func(ctrl *gomock.Controller) cfn {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().TemplateBodyFromChangeSet("cs-1", "phonetool-infrastructure-roles").Return(`Metadata:
+  TemplateVersion: v1.2.0
+Resources: {}`, nil)
+				return m
+			},
+			wantedVersion: "v1.2.0",
+		},
+		"should wrap the error if the template cannot be retrieved": {
+			given: func(ctrl *gomock.Controller) cfn {
+				m := mocks.NewMockcfn(ctrl)
+				m.EXPECT().TemplateBodyFromChangeSet("cs-1", "phonetool-infrastructure-roles").Return("", errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("get template of change set cs-1 for app stack phonetool-infrastructure-roles: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d := &AppDescriber{
+				app: "phonetool",
+				cfn: tc.given(ctrl),
+			}
+
+			// WHEN
+			actual, err := d.VersionAt("cs-1")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedVersion, actual)
+			}
+		})
+	}
+}
+
+func TestApp_YAMLString(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		URI:  "example.com",
+		Envs: []*EnvSummary{
+			{
+				Environment: &config.Environment{
+					Name:      "test",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				},
+			},
+		},
+		Services: []*ServiceSummary{
+			{
+				Workload: &config.Workload{
+					Name: "frontend",
+					Type: "Load Balanced Web Service",
+				},
+			},
+		},
+		Pipelines: []*PipelineSummary{
+			{
+				Pipeline: &codepipeline.Pipeline{
+					Name: "pipeline-phonetool",
+				},
+			},
+		},
+	}
+	wantedContent := `schemaVersion: "2023-10-01"
+name: phonetool
+uri: example.com
+environments:
+  - app: ""
+    name: test
+    region: us-west-2
+    accountID: "123456789012"
+    prod: false
+    registryURL: ""
+    executionRoleARN: ""
+    managerRoleARN: ""
+    managed: false
+services:
+  - app: ""
+    name: frontend
+    type: Load Balanced Web Service
+pipelines:
+  - name: pipeline-phonetool
+    region: ""
+    accountId: ""
+    stages: null
+    createdAt: "0001-01-01T00:00:00Z"
+    updatedAt: "0001-01-01T00:00:00Z"
+`
+
+	// WHEN
+	actual, err := app.YAMLString(AppRenderOptions{})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, wantedContent, actual)
+}
+
+func TestApp_HumanStringSections(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		URI:  "example.com",
+		Envs: []*EnvSummary{
+			{
+				Environment: &config.Environment{
+					Name:      "test",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				},
+			},
+		},
+		Services: []*ServiceSummary{
+			{
+				Workload: &config.Workload{
+					Name: "frontend",
+					Type: "Load Balanced Web Service",
+				},
+			},
+		},
+		Pipelines: []*PipelineSummary{
+			{
+				Pipeline: &codepipeline.Pipeline{
+					Name:       "pipeline-phonetool",
+					Repository: "phonetool/phonetool",
+					Branch:     "main",
+				},
+				Status: "Succeeded",
+			},
+			{
+				Pipeline: &codepipeline.Pipeline{
+					Name: "pipeline-phonetool-backend",
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inSections []AppSection
+
+		wantedContent string
+	}{
+		"renders all sections by default": {
+			wantedContent: `About
+
+  Name              phonetool
+  URI               alias: example.com
+
+Environments (1)
+
+  Name              AccountID           Region              Managed
+  ----              ---------           ------              -------
+  test              123456789012        us-west-2           ✗
+
+Services (1)
+
+  Name              Type
+  ----              ----
+  frontend          Load Balanced Web Service
+
+Pipelines (2)
+
+  Name                        Repository           Branch              LatestStatus
+  ----                        ----------           ------              ------------
+  pipeline-phonetool          phonetool/phonetool  main                Succeeded
+  pipeline-phonetool-backend  -                    -                   -
+`,
+		},
+		"renders only the environments section": {
+			inSections: []AppSection{SectionEnvironments},
+
+			wantedContent: `Environments (1)
+
+  Name              AccountID           Region              Managed
+  ----              ---------           ------              -------
+  test              123456789012        us-west-2           ✗
+`,
+		},
+		"skips the deployments section if deployments are unknown": {
+			inSections: []AppSection{SectionDeployments},
+
+			wantedContent: "",
+		},
+		"renders sections in a consistent order": {
+			inSections: []AppSection{SectionPipelines, SectionServices},
+
+			wantedContent: `Services (1)
+
+  Name              Type
+  ----              ----
+  frontend          Load Balanced Web Service
+
+Pipelines (2)
+
+  Name                        Repository           Branch              LatestStatus
+  ----                        ----------           ------              ------------
+  pipeline-phonetool          phonetool/phonetool  main                Succeeded
+  pipeline-phonetool-backend  -                    -                   -
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual := app.HumanStringSections(tc.inSections...)
+
+			// THEN
+			require.Equal(t, tc.wantedContent, actual)
+		})
+	}
+}
+
+func TestApp_HumanString_Deployments(t *testing.T) {
+	app := &App{
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test"}},
+			{Environment: &config.Environment{Name: "prod"}},
+		},
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "frontend"}},
+			{Workload: &config.Workload{Name: "backend"}},
+		},
+		Deployments: map[string][]string{
+			"test": {"frontend", "backend"},
+			"prod": {"frontend"},
+		},
+	}
+	wantedContent := `Deployments
+
+  Name              prod                test
+  ----              ----                ----
+  backend           -                   ✔
+  frontend          ✔                   ✔
+`
+
+	// WHEN
+	actual := app.HumanStringSections(SectionDeployments)
+
+	// THEN
+	require.Equal(t, wantedContent, actual)
+}
+
+func TestApp_HumanString_Color(t *testing.T) {
+	defer func(noColor bool) {
+		fatihcolor.NoColor = noColor
+	}(fatihcolor.NoColor)
+	app := &App{
+		Name: "phonetool",
+	}
+
+	// WHEN
+	fatihcolor.NoColor = false
+	colored := app.HumanString()
+	fatihcolor.NoColor = true
+	plain := app.HumanString()
+
+	// THEN
+	require.Contains(t, colored, "\x1b[", "expected escape sequences when colors are enabled")
+	require.NotContains(t, plain, "\x1b[", "expected no escape sequences when colors are disabled")
+}
+
+func TestApp_HumanString_FailedPipeline(t *testing.T) {
+	defer func(noColor bool) {
+		fatihcolor.NoColor = noColor
+	}(fatihcolor.NoColor)
+	fatihcolor.NoColor = false
+	app := &App{
+		Name: "phonetool",
+		Pipelines: []*PipelineSummary{
+			{
+				Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool"},
+				Status:   "Failed",
+			},
+		},
+	}
+
+	// WHEN
+	actual := app.HumanStringSections(SectionPipelines)
+
+	// THEN
+	require.Contains(t, actual, fatihcolor.New(fatihcolor.FgHiRed).Sprint("Failed"), "expected failed pipelines to be colored in red")
+}
+
+func TestApp_HumanString_PipelineStages(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		Pipelines: []*PipelineSummary{
+			{
+				Pipeline: &codepipeline.Pipeline{
+					Name:       "pipeline-phonetool",
+					Repository: "badgoose/phonetool",
+					Branch:     "main",
+				},
+				Status: "Succeeded",
+				StageActions: []*PipelineStage{
+					{
+						Name: "Source",
+						Actions: []*PipelineAction{
+							{Name: "SourceCodeFor-phonetool", Category: "Source", Provider: "GitHub", Details: "Repository: badgoose/phonetool"},
+						},
+					},
+					{
+						Name: "DeployTo-test",
+						Actions: []*PipelineAction{
+							{Name: "CreateOrUpdate-test-frontend", Category: "Deploy", Provider: "CloudFormation", Details: "StackName: phonetool-test-frontend"},
+							{Name: "Approve", Category: "Approval", Provider: "Manual"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// WHEN
+	actual := app.HumanStringSections(SectionPipelines)
+
+	// THEN
+	require.Equal(t, `Pipelines (1)
+
+  Name                Repository          Branch              LatestStatus
+  ----                ----------          ------              ------------
+  pipeline-phonetool  badgoose/phonetool  main                Succeeded
+    - Source
+      - SourceCodeFor-phonetool (Source, GitHub): Repository: badgoose/phonetool
+    - DeployTo-test
+      - CreateOrUpdate-test-frontend (Deploy, CloudFormation): StackName: phonetool-test-frontend
+      - Approve (Approval, Manual)
+`, actual)
+}
+
+func TestApp_JSONString(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test"}},
+			{Environment: &config.Environment{Name: "prod"}},
+		},
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+			{Workload: &config.Workload{Name: "backend", Type: "Load Balanced Web Service"}},
+			{Workload: &config.Workload{Name: "backend", Type: "Backend Service"}},
+		},
+	}
+	wantedContent := `{"schemaVersion":"2023-10-01","name":"phonetool","environments":[{"app":"","name":"prod","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":"","managed":false},{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":"","managed":false}],"services":[{"app":"","name":"backend","type":"Backend Service"},{"app":"","name":"backend","type":"Load Balanced Web Service"},{"app":"","name":"frontend","type":"Load Balanced Web Service"}],"pipelines":[]}
+`
+
+	// WHEN
+	actual, err := app.JSONString()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, wantedContent, actual)
+	require.Equal(t, "test", app.Envs[0].Name, "expected the original environments to be left untouched")
+}
+
+func TestApp_JSONString_EmptyArrays(t *testing.T) {
+	// GIVEN
+	app := &App{Name: "phonetool"}
+
+	// WHEN
+	actual, err := app.JSONString()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `{"schemaVersion":"2023-10-01","name":"phonetool","environments":[],"services":[],"pipelines":[]}
+`, actual)
+	require.Nil(t, app.Pipelines, "expected the original description to be left untouched")
+}
+
+func TestApp_JSONStringIndent(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+			{Workload: &config.Workload{Name: "backend", Type: "Backend Service"}},
+		},
+	}
+	wantedContent := `{
+  "schemaVersion": "2023-10-01",
+  "name": "phonetool",
+  "environments": [],
+  "services": [
+    {
+      "app": "",
+      "name": "backend",
+      "type": "Backend Service"
+    },
+    {
+      "app": "",
+      "name": "frontend",
+      "type": "Load Balanced Web Service"
+    }
+  ],
+  "pipelines": []
+}
+`
+
+	// WHEN
+	actual, err := app.JSONStringIndent(AppRenderOptions{})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, wantedContent, actual)
+}
+
+func TestApp_Normalize(t *testing.T) {
+	testCases := map[string]struct {
+		inURI string
+
+		wantedURI      string
+		wantedWarnings []string
+	}{
+		"empty URI": {
+			inURI: "",
+
+			wantedURI: "",
+		},
+		"URI with a scheme": {
+			inURI: " https://example.com ",
+
+			wantedURI: "https://example.com",
+		},
+		"bare domain": {
+			inURI: "example.com",
+
+			wantedURI: "example.com",
+		},
+		"malformed domain": {
+			inURI: "example..com",
+
+			wantedURI:      "example..com",
+			wantedWarnings: []string{"URI example..com is not a valid domain name"},
+		},
+		"domain with an invalid character": {
+			inURI: "my_app.example.com",
+
+			wantedURI:      "my_app.example.com",
+			wantedWarnings: []string{"URI my_app.example.com is not a valid domain name"},
+		},
+		"URL without a host": {
+			inURI: "https://",
+
+			wantedURI:      "https://",
+			wantedWarnings: []string{"URI https:// is not a valid URL"},
+		},
+		"URL with an unexpected scheme": {
+			inURI: "ftp://example.com",
+
+			wantedURI:      "ftp://example.com",
+			wantedWarnings: []string{"URI ftp://example.com has scheme ftp instead of http or https"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app := &App{
+				URI: tc.inURI,
+			}
+
+			// WHEN
+			app.Normalize()
+
+			// THEN
+			require.Equal(t, tc.wantedURI, app.URI)
+			require.Equal(t, tc.wantedWarnings, app.Warnings)
+		})
+	}
+}
+
+func TestApp_HumanString_URIAndWarnings(t *testing.T) {
+	app := &App{
+		Name:     "phonetool",
+		Warnings: []string{"something is off"},
+	}
+	wantedContent := `About
+
+  Name              phonetool
+  URI               (none)
+`
+
+	// WHEN
+	actual := app.HumanStringSections(SectionAbout, SectionWarnings)
+
+	// THEN
+	require.Equal(t, wantedContent+`
+Warnings
+
+  - something is off
+`, actual)
+}
+
+func TestApp_HumanString_URI(t *testing.T) {
+	testCases := map[string]struct {
+		inURI string
+
+		wanted string
+	}{
+		"renders a full URL as is": {
+			inURI: "https://example.com",
+
+			wanted: "https://example.com",
+		},
+		"prefixes a bare domain with alias": {
+			inURI: "example.com",
+
+			wanted: "alias: example.com",
+		},
+		"renders none if empty": {
+			inURI: "",
+
+			wanted: "(none)",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			app := &App{
+				Name: "phonetool",
+				URI:  tc.inURI,
+			}
+
+			// WHEN
+			actual := app.HumanStringSections(SectionAbout)
+
+			// THEN
+			require.Equal(t, fmt.Sprintf(`About
+
+  Name              phonetool
+  URI               %s
+`, tc.wanted), actual)
+		})
+	}
+}
+
+type appDescriberMocks struct {
+	configStore *mocks.MockAppConfigStore
+	deployStore *mocks.MockDeployedServicesLister
+	pipelineSvc *mocks.MockpipelinesGetter
+	cfn         *mocks.Mockcfn
+	stackSetSvc *mocks.MockstackSetDescriber
+}
+
+func TestAppDescriber_Describe(t *testing.T) {
+	testError := errors.New("some error")
+	testCreationTime := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	testLastUpdatedTime := time.Date(2021, time.April, 1, 12, 0, 0, 0, time.UTC)
+	mockEmptyApp := func(m appDescriberMocks) {
+		m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+		m.configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+		m.configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+		m.pipelineSvc.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return(nil, nil)
+		m.stackSetSvc.EXPECT().InstanceSummaries("phonetool-infrastructure").Return(nil, nil)
+	}
+	testCases := map[string]struct {
+		inRegion              string
+		inSessionRegion       string
+		inIncludeStackARNs    bool
+		inGroupServicesByType bool
+		inOpts                []AppDescriberOption
+		setupMocks            func(m appDescriberMocks)
+
+		wantedApp           *App
+		wantedRenderOptions AppRenderOptions
+		wantedError         error
+	}{
+		"returns error if fail to describe the app stack": {
+			inIncludeStackARNs: true,
+			setupMocks: func(m appDescriberMocks) {
+				mockEmptyApp(m)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("describe app stack phonetool-infrastructure-roles: %w", testError),
+		},
+		"returns error if fail to describe the app stack set": {
+			inIncludeStackARNs: true,
+			setupMocks: func(m appDescriberMocks) {
+				mockEmptyApp(m)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+				m.stackSetSvc.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{}, testError)
+			},
+
+			wantedError: fmt.Errorf("describe app stack set phonetool-infrastructure: %w", testError),
+		},
+		"returns a region error if the home region rejects the credentials": {
+			inRegion:           "me-south-1",
+			inIncludeStackARNs: true,
+			setupMocks: func(m appDescriberMocks) {
+				mockEmptyApp(m)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(nil, awserr.New("UnrecognizedClientException", "The security token included in the request is invalid", nil))
+			},
+
+			wantedError: errors.New("describe app stack phonetool-infrastructure-roles: region me-south-1 may not be enabled in the account: UnrecognizedClientException: The security token included in the request is invalid"),
+		},
+		"warns if fail to describe the app stack without the stack ARNs": {
+			setupMocks: func(m appDescriberMocks) {
+				mockEmptyApp(m)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(nil, testError)
+			},
+
+			wantedApp: &App{
+				Name:        "phonetool",
+				Deployments: map[string][]string{},
+				Warnings:    []string{"describe app stack phonetool-infrastructure-roles: some error"},
+			},
+		},
+		"groups services by type": {
+			inGroupServicesByType: true,
+			setupMocks: func(m appDescriberMocks) {
+				mockEmptyApp(m)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+			},
+
+			wantedApp: &App{
+				Name:        "phonetool",
+				Deployments: map[string][]string{},
+			},
+			wantedRenderOptions: AppRenderOptions{GroupServicesByType: true},
+		},
+		"includes the stack ARNs": {
+			inIncludeStackARNs: true,
+			setupMocks: func(m appDescriberMocks) {
+				mockEmptyApp(m)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+					StackId: aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1"),
+				}, nil)
+				m.stackSetSvc.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{
+					ARN: "arn:aws:cloudformation:us-west-2:123456789012:stackset/phonetool-infrastructure:1",
+				}, nil)
+			},
+
+			wantedApp: &App{
+				Name:        "phonetool",
+				Deployments: map[string][]string{},
+				StackARN:    "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1",
+				StackSetARN: "arn:aws:cloudformation:us-west-2:123456789012:stackset/phonetool-infrastructure:1",
+			},
+		},
+		"includes the stack ARNs of an app in GovCloud": {
+			inIncludeStackARNs: true,
+			setupMocks: func(m appDescriberMocks) {
+				mockEmptyApp(m)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+					StackId: aws.String("arn:aws-us-gov:cloudformation:us-gov-west-1:123456789012:stack/phonetool-infrastructure-roles/1"),
+				}, nil)
+				m.stackSetSvc.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{
+					ARN: "arn:aws-us-gov:cloudformation:us-gov-west-1:123456789012:stackset/phonetool-infrastructure:1",
+				}, nil)
+			},
+
+			wantedApp: &App{
+				Name:        "phonetool",
+				Deployments: map[string][]string{},
+				StackARN:    "arn:aws-us-gov:cloudformation:us-gov-west-1:123456789012:stack/phonetool-infrastructure-roles/1",
+				StackSetARN: "arn:aws-us-gov:cloudformation:us-gov-west-1:123456789012:stackset/phonetool-infrastructure:1",
+			},
+		},
+		"includes the stack ARNs of an app in China": {
+			inIncludeStackARNs: true,
+			setupMocks: func(m appDescriberMocks) {
+				mockEmptyApp(m)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+					StackId: aws.String("arn:aws-cn:cloudformation:cn-north-1:123456789012:stack/phonetool-infrastructure-roles/1"),
+				}, nil)
+				m.stackSetSvc.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{
+					ARN: "arn:aws-cn:cloudformation:cn-north-1:123456789012:stackset/phonetool-infrastructure:1",
+				}, nil)
+			},
+
+			wantedApp: &App{
+				Name:        "phonetool",
+				Deployments: map[string][]string{},
+				StackARN:    "arn:aws-cn:cloudformation:cn-north-1:123456789012:stack/phonetool-infrastructure-roles/1",
+				StackSetARN: "arn:aws-cn:cloudformation:cn-north-1:123456789012:stackset/phonetool-infrastructure:1",
+			},
+		},
+		"returns error if fail to get application": {
+			setupMocks: func(m appDescriberMocks) {
+				m.configStore.EXPECT().GetApplication("phonetool").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("get application phonetool: %w", testError),
+		},
+		"returns error if the application lives in another region": {
+			inSessionRegion: "us-east-1",
+			setupMocks: func(m appDescriberMocks) {
+				m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", Region: "us-west-2"}, nil)
+			},
+
+			wantedError: errors.New("application phonetool lives in region us-west-2 but the default session is in region us-east-1: set the AWS_REGION environment variable or use a profile with region us-west-2"),
+		},
+		"returns error if fail to list environments": {
+			setupMocks: func(m appDescriberMocks) {
+				m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list environments in application phonetool: %w", testError),
+		},
+		"returns error if fail to list services": {
+			setupMocks: func(m appDescriberMocks) {
+				m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				m.configStore.EXPECT().ListServices("phonetool").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list services in application phonetool: %w", testError),
+		},
+		"returns error if fail to list pipelines": {
+			setupMocks: func(m appDescriberMocks) {
+				m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				m.configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list pipelines in application phonetool: %w", testError),
+		},
+		"warns if fail to get the latest execution status of a pipeline": {
+			setupMocks: func(m appDescriberMocks) {
+				m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				m.configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline-phonetool"},
+				}, nil)
+				m.pipelineSvc.EXPECT().LatestExecutionStatus("pipeline-phonetool").Return("", testError)
+				m.stackSetSvc.EXPECT().InstanceSummaries("phonetool-infrastructure").Return(nil, nil)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+			},
+
+			wantedApp: &App{
+				Name:        "phonetool",
+				Deployments: map[string][]string{},
+				Pipelines: []*PipelineSummary{
+					{Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool"}},
+				},
+				Warnings: []string{"get latest execution status of pipeline pipeline-phonetool: some error"},
+			},
+		},
+		"warns if fail to list the app stack set instances": {
+			setupMocks: func(m appDescriberMocks) {
+				m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+				}, nil)
+				m.configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return(nil, nil)
+				m.stackSetSvc.EXPECT().InstanceSummaries("phonetool-infrastructure").Return(nil, testError)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, nil)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+			},
+
+			wantedApp: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}},
+				},
+				Deployments: map[string][]string{"test": nil},
+				Warnings:    []string{"list instances of app stack set phonetool-infrastructure: some error"},
+			},
+		},
+		"warns if fail to list deployed services": {
+			setupMocks: func(m appDescriberMocks) {
+				m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+				}, nil)
+				m.configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return(nil, nil)
+				m.stackSetSvc.EXPECT().InstanceSummaries("phonetool-infrastructure").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, testError)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+			},
+
+			wantedApp: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test"}},
+				},
+				Deployments: map[string][]string{},
+				Warnings:    []string{"list deployed services in environment test: some error"},
+			},
+		},
+		"returns error if fail to list deployed services while filtering services by deployment": {
+			inOpts: []AppDescriberOption{WithOnlyDeployedServices()},
+			setupMocks: func(m appDescriberMocks) {
+				m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+				}, nil)
+				m.configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return(nil, nil)
+				m.stackSetSvc.EXPECT().InstanceSummaries("phonetool-infrastructure").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list deployed services in environment test: %w", testError),
+		},
+		"success": {
+			setupMocks: func(m appDescriberMocks) {
+				m.configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name:   "phonetool",
+					Domain: "example.com",
+				}, nil)
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{
+						App:            "phonetool",
+						Name:           "test",
+						Region:         "us-west-2",
+						AccountID:      "123456789012",
+						ManagerRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+					},
+					{
+						App:       "phonetool",
+						Name:      "prod",
+						Region:    "us-east-1",
+						AccountID: "123456789012",
+						Prod:      true,
+					},
+				}, nil)
+				m.configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+					{
+						App:  "phonetool",
+						Name: "frontend",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline-phonetool"},
+				}, nil)
+				m.pipelineSvc.EXPECT().LatestExecutionStatus("pipeline-phonetool").Return("Succeeded", nil)
+				m.stackSetSvc.EXPECT().InstanceSummaries("phonetool-infrastructure").Return([]stackset.InstanceSummary{
+					{Account: "123456789012", Region: "us-east-1"},
+				}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend"}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "prod").Return([]string{}, nil)
+				m.cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+					StackId:         aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1"),
+					CreationTime:    &testCreationTime,
+					LastUpdatedTime: &testLastUpdatedTime,
+				}, nil)
+			},
+
+			wantedApp: &App{
+				Name: "phonetool",
+				URI:  "example.com",
+				Envs: []*EnvSummary{
+					{
+						Environment: &config.Environment{
+							Name:      "test",
+							Region:    "us-west-2",
+							AccountID: "123456789012",
+						},
+					},
+					{
+						Environment: &config.Environment{
+							Name:      "prod",
+							Region:    "us-east-1",
+							AccountID: "123456789012",
+							Prod:      true,
+						},
+						Managed: true,
+					},
+				},
+				Services: []*ServiceSummary{
+					{
+						Workload: &config.Workload{
+							Name: "frontend",
+							Type: "Load Balanced Web Service",
+						},
+					},
+				},
+				Deployments: map[string][]string{
+					"test": {"frontend"},
+					"prod": {},
+				},
+				Pipelines: []*PipelineSummary{
+					{
+						Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool"},
+						Status:   "Succeeded",
+					},
+				},
+				CreationTime:    &testCreationTime,
+				LastUpdatedTime: &testLastUpdatedTime,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := appDescriberMocks{
+				configStore: mocks.NewMockAppConfigStore(ctrl),
+				deployStore: mocks.NewMockDeployedServicesLister(ctrl),
+				pipelineSvc: mocks.NewMockpipelinesGetter(ctrl),
+				cfn:         mocks.NewMockcfn(ctrl),
+				stackSetSvc: mocks.NewMockstackSetDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &AppDescriber{
+				app:           "phonetool",
+				region:        tc.inRegion,
+				sessionRegion: tc.inSessionRegion,
+				configStore:   m.configStore,
+				deployStore:   m.deployStore,
+				pipelineSvc:   m.pipelineSvc,
+				cfn:           m.cfn,
+				stackSetSvc:   m.stackSetSvc,
+
+				includeStackARNs:    tc.inIncludeStackARNs,
+				groupServicesByType: tc.inGroupServicesByType,
+			}
+			for _, opt := range tc.inOpts {
+				opt(d)
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, actual)
+				require.Equal(t, tc.wantedRenderOptions, d.RenderOptions())
+			}
+		})
+	}
+}
+
+func TestApp_HumanString_Regions(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvs []*EnvSummary
+
+		wantedContent string
+	}{
+		"should not summarize regions with a single environment": {
+			inEnvs: []*EnvSummary{
+				{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}},
+			},
+
+			wantedContent: `Environments (1)
+
+  Name              AccountID           Region              Managed
+  ----              ---------           ------              -------
+  test              123456789012        us-west-2           ✗
+`,
+		},
+		"should count environments per region": {
+			inEnvs: []*EnvSummary{
+				{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "eu-west-1"}},
+				{Environment: &config.Environment{Name: "staging", AccountID: "123456789012", Region: "us-east-1"}},
+				{Environment: &config.Environment{Name: "prod", AccountID: "123456789012", Region: "us-east-1"}},
+			},
+
+			wantedContent: `Environments (3)
+
+  Name              AccountID           Region              Managed
+  ----              ---------           ------              -------
+  prod              123456789012        us-east-1           ✗
+  staging           123456789012        us-east-1           ✗
+  test              123456789012        eu-west-1           ✗
+
+  Regions: us-east-1 (2), eu-west-1 (1)
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app := &App{
+				Name: "phonetool",
+				Envs: tc.inEnvs,
+			}
+
+			// WHEN
+			actual := app.HumanStringSections(SectionEnvironments)
+
+			// THEN
+			require.Equal(t, tc.wantedContent, actual)
+		})
+	}
+}
+
+func TestApp_AccountIDs(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvs []*EnvSummary
+
+		wantedAccounts []string
+		wantedAbout    string
+	}{
+		"should not list accounts of a single account app": {
+			inEnvs: []*EnvSummary{
+				{Environment: &config.Environment{Name: "test", AccountID: "123456789012"}},
+				{Environment: &config.Environment{Name: "prod", AccountID: "123456789012"}},
+			},
+
+			wantedAccounts: []string{"123456789012"},
+			wantedAbout: `About
+
+  Name              phonetool
+  URI               (none)
+`,
+		},
+		"should list unique accounts in order": {
+			inEnvs: []*EnvSummary{
+				{Environment: &config.Environment{Name: "test", AccountID: "222222222222"}},
+				{Environment: &config.Environment{Name: "staging", AccountID: "111111111111"}},
+				{Environment: &config.Environment{Name: "prod", AccountID: "222222222222"}},
+			},
+
+			wantedAccounts: []string{"111111111111", "222222222222"},
+			wantedAbout: `About
+
+  Name              phonetool
+  URI               (none)
+  Accounts          111111111111, 222222222222
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app := &App{
+				Name: "phonetool",
+				Envs: tc.inEnvs,
+			}
+
+			// WHEN
+			accounts := app.AccountIDs()
+			about := app.HumanStringSections(SectionAbout)
+
+			// THEN
+			require.Equal(t, tc.wantedAccounts, accounts)
+			require.Equal(t, tc.wantedAbout, about)
+		})
+	}
+}
+
+func TestApp_HumanString_Resources(t *testing.T) {
+	app := &App{
+		Name:        "phonetool",
+		StackARN:    "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1",
+		StackSetARN: "arn:aws:cloudformation:us-west-2:123456789012:stackset/phonetool-infrastructure:1",
+	}
+
+	// WHEN
+	actual := app.HumanStringSections(SectionResources)
+
+	// THEN
+	require.Equal(t, `Resources
+
+  Stack             arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-infrastructure-roles/1
+  Stack set         arn:aws:cloudformation:us-west-2:123456789012:stackset/phonetool-infrastructure:1
+`, actual)
+	require.Empty(t, (&App{Name: "phonetool"}).HumanStringSections(SectionResources), "the section is omitted without stack ARNs")
+}
+
+// failingWriter is an io.Writer that fails every write.
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("some error")
+}
+
+func TestApp_WriteHumanTo(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		URI:  "https://example.com",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}},
+			{Environment: &config.Environment{Name: "prod", AccountID: "123456789012", Region: "us-east-1"}},
+		},
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+		},
+		Deployments: map[string][]string{
+			"test": {"frontend"},
+		},
+		Warnings: []string{"something is off"},
+	}
+
+	t.Run("should write the same content as HumanString", func(t *testing.T) {
+		// GIVEN
+		var b bytes.Buffer
+
+		// WHEN
+		err := app.WriteHumanTo(&b, AppRenderOptions{})
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, app.HumanString(), b.String())
+	})
+	t.Run("should return the error of the writer", func(t *testing.T) {
+		// WHEN
+		err := app.WriteHumanTo(failingWriter{}, AppRenderOptions{})
+
+		// THEN
+		require.EqualError(t, err, "some error")
+	})
+}
+
+func TestApp_HumanStringWithOptions(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+		},
+	}
+
+	t.Run("should default to the layout of HumanString", func(t *testing.T) {
+		// WHEN
+		actual := app.HumanStringWithOptions(AppRenderOptions{})
+
+		// THEN
+		require.Equal(t, app.HumanString(), actual)
+	})
+	t.Run("should lay out tables with the given options", func(t *testing.T) {
+		// WHEN
+		actual := app.HumanStringWithOptions(AppRenderOptions{
+			HumanStringOptions: HumanStringOptions{
+				MinCellWidth: 10,
+				CellPadding:  1,
+				PaddingChar:  '.',
+			},
+		})
+
+		// THEN
+		require.Equal(t, `About
+
+  Name....phonetool
+  URI.....(none)
+
+Environments (0)
+
+  Name....AccountID.Region....Managed
+  ----....---------.------....-------
+
+Services (1)
+
+  Name.....Type
+  ----.....----
+  frontend.Load Balanced Web Service
+
+Pipelines (0)
+
+  Name....Repository.Branch....LatestStatus
+  ----....----------.------....------------
+`, actual)
+	})
+}
+
+func TestApp_HumanString_GroupServicesByType(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "worker", Type: "Backend Service"}},
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+			{Workload: &config.Workload{Name: "api", Type: "Backend Service"}},
+		},
+	}
+
+	// WHEN
+	actual := app.HumanStringWithOptions(AppRenderOptions{Sections: []AppSection{SectionServices}, GroupServicesByType: true})
+
+	// THEN
+	require.Equal(t, `Services (3)
+
+  Backend Service
+    - api
+    - worker
+
+  Load Balanced Web Service
+    - frontend
+`, actual)
+}
+
+func TestApp_HumanString_ServiceURLs(t *testing.T) {
+	testCases := map[string]struct {
+		inGroupServicesByType bool
+
+		wanted string
+	}{
+		"lists the URLs under each service": {
+			wanted: `Services (2)
+
+  Name              Type
+  ----              ----
+  api               Backend Service
+  frontend          Load Balanced Web Service
+    - prod: https://frontend.prod.phonetool.com
+    - test: http://test-lb.us-west-2.elb.amazonaws.com/frontend
+`,
+		},
+		"lists the URLs under each service grouped by type": {
+			inGroupServicesByType: true,
+
+			wanted: `Services (2)
+
+  Backend Service
+    - api
+
+  Load Balanced Web Service
+    - frontend
+      - prod: https://frontend.prod.phonetool.com
+      - test: http://test-lb.us-west-2.elb.amazonaws.com/frontend
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			app := &App{
+				Name: "phonetool",
+				Services: []*ServiceSummary{
+					{Workload: &config.Workload{Name: "api", Type: "Backend Service"}},
+					{
+						Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"},
+						URLs: map[string]string{
+							"test": "http://test-lb.us-west-2.elb.amazonaws.com/frontend",
+							"prod": "https://frontend.prod.phonetool.com",
+						},
+					},
+				},
+			}
+
+			// WHEN
+			actual := app.HumanStringWithOptions(AppRenderOptions{Sections: []AppSection{SectionServices}, GroupServicesByType: tc.inGroupServicesByType})
+
+			// THEN
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
+
+// fakeWebSvcURIDescriber returns the URL of a web service keyed by environment name.
+type fakeWebSvcURIDescriber map[string]string
+
+func (d fakeWebSvcURIDescriber) URI(envName string) (string, error) {
+	uri, ok := d[envName]
+	if !ok {
+		return "", fmt.Errorf("no URL in environment %s", envName)
+	}
+	return uri, nil
+}
+
+func TestAppDescriber_Describe_ServiceURLs(t *testing.T) {
+	testCases := map[string]struct {
+		inURIs map[string]string
+
+		wantedServices []*ServiceSummary
+		wantedError    error
+	}{
+		"resolves the URLs of the web services in the environments they are deployed to": {
+			inURIs: map[string]string{
+				"test": "http://test-lb.us-west-2.elb.amazonaws.com/frontend",
+			},
+
+			wantedServices: []*ServiceSummary{
+				{Workload: &config.Workload{Name: "api", Type: "Backend Service"}},
+				{
+					Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"},
+					URLs: map[string]string{
+						"test": "http://test-lb.us-west-2.elb.amazonaws.com/frontend",
+					},
+				},
+			},
+		},
+		"returns error if fail to resolve a URL": {
+			inURIs: map[string]string{},
+
+			wantedError: errors.New("resolve URL of service frontend in environment test: no URL in environment test"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+				{Name: "test"},
+				{Name: "prod"},
+			}, nil)
+			configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+				{Name: "api", Type: "Backend Service"},
+				{Name: "frontend", Type: "Load Balanced Web Service"},
+			}, nil)
+			deployStore := mocks.NewMockDeployedServicesLister(ctrl)
+			deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api", "frontend"}, nil)
+			deployStore.EXPECT().ListDeployedServices("phonetool", "prod").Return([]string{"api"}, nil)
+			cfn := mocks.NewMockcfn(ctrl)
+			cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				deployStore: deployStore,
+				cfn:         cfn,
+				newWebSvc: func(svc string) (webSvcURIDescriber, error) {
+					require.Equal(t, "frontend", svc, "only Load Balanced Web Services have a URL")
+					return fakeWebSvcURIDescriber(tc.inURIs), nil
+				},
+
+				includeServiceURLs: true,
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedServices, actual.Services)
+			}
+		})
+	}
+}
+
+// concurrencyTrackingStacks is a stackDescriber that records the maximum number of stacks described at the same time.
+type concurrencyTrackingStacks struct {
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	updated     map[string]time.Time // Last update time keyed by stack name.
+}
+
+func (s *concurrencyTrackingStacks) Describe(stackName string) (*cloudformation.StackDescription, error) {
+	s.mu.Lock()
+	s.inFlight++
+	if s.inFlight > s.maxInFlight {
+		s.maxInFlight = s.inFlight
+	}
+	s.mu.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	s.mu.Lock()
+	s.inFlight--
+	s.mu.Unlock()
+	updated := s.updated[stackName]
+	return &cloudformation.StackDescription{LastUpdatedTime: &updated}, nil
+}
+
+func (s *concurrencyTrackingStacks) TemplateBody(stackName string) (string, error) {
+	return "", nil
+}
+
+func (s *concurrencyTrackingStacks) Metadata(opt cloudformation.MetadataOpts) (string, error) {
+	return "", nil
+}
+
+func (s *concurrencyTrackingStacks) StackResources(name string) ([]*cloudformation.StackResource, error) {
+	return nil, nil
+}
+
+func TestAppDescriber_Describe_ServiceConcurrency(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	const numSvcs = 8
+	var svcs []*config.Workload
+	var svcNames []string
+	stacks := &concurrencyTrackingStacks{updated: make(map[string]time.Time)}
+	for i := 0; i < numSvcs; i++ {
+		name := fmt.Sprintf("svc-%d", i)
+		svcs = append(svcs, &config.Workload{Name: name, Type: "Backend Service"})
+		svcNames = append(svcNames, name)
+		stacks.updated["phonetool-test-"+name] = time.Date(2021, time.January, i+1, 12, 0, 0, 0, time.UTC)
+	}
+	configStore := mocks.NewMockAppConfigStore(ctrl)
+	configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+	configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+	configStore.EXPECT().ListServices("phonetool").Return(svcs, nil)
+	deployStore := mocks.NewMockDeployedServicesLister(ctrl)
+	deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(svcNames, nil)
+	appCFN := mocks.NewMockcfn(ctrl)
+	appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+	d := &AppDescriber{
+		app:         "phonetool",
+		configStore: configStore,
+		deployStore: deployStore,
+		cfn:         appCFN,
+		newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+			return stacks, nil
+		},
+
+		includeLastDeployed: true,
+	}
+	WithServiceConcurrency(3)(d)
+
+	// WHEN
+	actual, err := d.Describe()
+
+	// THEN
+	require.NoError(t, err)
+	require.LessOrEqual(t, stacks.maxInFlight, 3, "expected at most 3 services to be enriched at the same time")
+	require.Len(t, actual.Services, numSvcs)
+	for i, svc := range actual.Services {
+		require.Equal(t, svcNames[i], svc.Name, "expected the services to keep their order")
+		wanted := stacks.updated["phonetool-test-"+svc.Name]
+		require.Equal(t, &wanted, svc.LastDeployed, "expected each service to have the deployment time of its own stack")
+	}
+}
+
+func TestAppDescriber_Describe_ServiceDeploymentFilter(t *testing.T) {
+	testCases := map[string]struct {
+		inFilter        serviceDeploymentFilter
+		withDeployStore bool
+
+		wantedServices []*ServiceSummary
+		wantedError    error
+	}{
+		"lists only the deployed services": {
+			inFilter:        deployedServices,
+			withDeployStore: true,
+
+			wantedServices: []*ServiceSummary{
+				{Workload: &config.Workload{Name: "api", Type: "Backend Service"}},
+				{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+			},
+		},
+		"lists only the services that were never deployed": {
+			inFilter:        undeployedServices,
+			withDeployStore: true,
+
+			wantedServices: []*ServiceSummary{
+				{Workload: &config.Workload{Name: "worker", Type: "Backend Service"}},
+			},
+		},
+		"returns error without a deploy store": {
+			inFilter: deployedServices,
+
+			wantedError: errors.New("filter services of application phonetool by deployment status: the deployed services are unknown without a deploy store"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+				{Name: "test"},
+				{Name: "prod"},
+			}, nil)
+			configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+				{Name: "api", Type: "Backend Service"},
+				{Name: "frontend", Type: "Load Balanced Web Service"},
+				{Name: "worker", Type: "Backend Service"},
+			}, nil)
+			cfn := mocks.NewMockcfn(ctrl)
+			cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				cfn:         cfn,
+
+				svcDeployFilter: tc.inFilter,
+			}
+			if tc.withDeployStore {
+				deployStore := mocks.NewMockDeployedServicesLister(ctrl)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api", "frontend"}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "prod").Return([]string{"api"}, nil)
+				d.deployStore = deployStore
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedServices, actual.Services)
+			}
+		})
+	}
+}
+
+func TestAppDescriber_Describe_BestEffort(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		inBestEffort    bool
+		mockSvcsErr     error
+		mockPipelineErr error
+
+		wantedServices  []*ServiceSummary
+		wantedPipelines []*PipelineSummary
+		wantedWarnings  []string
+		wantedError     error
+	}{
+		"returns error if fail to list services without best effort": {
+			mockSvcsErr: testError,
+
+			wantedError: fmt.Errorf("list services in application phonetool: %w", testError),
+		},
+		"returns error if fail to list pipelines without best effort": {
+			mockPipelineErr: testError,
+
+			wantedError: fmt.Errorf("list pipelines in application phonetool: %w", testError),
+		},
+		"attaches a warning if fail to list services": {
+			inBestEffort: true,
+			mockSvcsErr:  testError,
+
+			wantedPipelines: []*PipelineSummary{
+				{Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool"}, Status: "Succeeded"},
+			},
+			wantedWarnings: []string{"list services in application phonetool: some error"},
+		},
+		"attaches a warning if fail to list pipelines": {
+			inBestEffort:    true,
+			mockPipelineErr: testError,
+
+			wantedServices: []*ServiceSummary{
+				{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+			},
+			wantedWarnings: []string{"list pipelines in application phonetool: some error"},
+		},
+		"attaches a warning per failure": {
+			inBestEffort:    true,
+			mockSvcsErr:     testError,
+			mockPipelineErr: testError,
+
+			wantedWarnings: []string{
+				"list services in application phonetool: some error",
+				"list pipelines in application phonetool: some error",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			var svcs []*config.Workload
+			if tc.mockSvcsErr == nil {
+				svcs = []*config.Workload{{Name: "frontend", Type: "Load Balanced Web Service"}}
+			}
+			configStore.EXPECT().ListServices("phonetool").Return(svcs, tc.mockSvcsErr)
+			pipelineSvc := mocks.NewMockpipelinesGetter(ctrl)
+			if tc.mockPipelineErr != nil {
+				pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, tc.mockPipelineErr)
+			} else {
+				pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return([]*codepipeline.Pipeline{{Name: "pipeline-phonetool"}}, nil).AnyTimes()
+				pipelineSvc.EXPECT().LatestExecutionStatus("pipeline-phonetool").Return("Succeeded", nil).AnyTimes()
+			}
+			cfn := mocks.NewMockcfn(ctrl)
+			cfn.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				pipelineSvc: pipelineSvc,
+				cfn:         cfn,
+
+				bestEffort: tc.inBestEffort,
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedServices, actual.Services)
+				require.Equal(t, tc.wantedPipelines, actual.Pipelines)
+				require.Equal(t, tc.wantedWarnings, actual.Warnings)
+			}
+		})
+	}
+}
+
+func TestAppDescriber_Describe_FailOnWarnings(t *testing.T) {
+	testCases := map[string]struct {
+		inURI            string
+		inFailOnWarnings bool
+
+		wantedError error
+	}{
+		"returns the description if it has no warnings": {
+			inURI:            "https://example.com",
+			inFailOnWarnings: true,
+		},
+		"returns the description of an app with a custom domain": {
+			inURI:            "example.com",
+			inFailOnWarnings: true,
+		},
+		"returns the description with its warnings by default": {
+			inURI: "example..com",
+		},
+		"returns error with the warnings of the description": {
+			inURI:            "example..com",
+			inFailOnWarnings: true,
+
+			wantedError: errors.New("application phonetool has 1 warning: URI example..com is not a valid domain name"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", Domain: tc.inURI}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+			appCFN := mocks.NewMockcfn(ctrl)
+			appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+			var opts []AppDescriberOption
+			if tc.inFailOnWarnings {
+				opts = append(opts, WithFailOnWarnings())
+			}
+			d := NewAppDescriberFromStore("phonetool", configStore, appCFN, opts...)
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				var warningsErr *WarningsError
+				require.True(t, errors.As(err, &warningsErr))
+				require.Equal(t, []string{"URI example..com is not a valid domain name"}, warningsErr.Warnings)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.inURI, actual.URI)
+		})
+	}
+}
+
+func TestAppDescriber_Describe_EnvironmentTags(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		setupEnvCFN func(m *mocks.MockstackDescriber)
+
+		wantedEnvs  []*EnvSummary
+		wantedError error
+	}{
+		"retrieves the tags of each environment stack": {
+			setupEnvCFN: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					Tags: []*awscfn.Tag{
+						{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+						{Key: aws.String("cost-center"), Value: aws.String("1234")},
+					},
+				}, nil)
+			},
+
+			wantedEnvs: []*EnvSummary{
+				{
+					Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					Tags: map[string]string{
+						"copilot-application": "phonetool",
+						"cost-center":         "1234",
+					},
+				},
+			},
+		},
+		"returns error if fail to describe an environment stack": {
+			setupEnvCFN: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Describe("phonetool-test").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("describe stack phonetool-test of environment test: %w", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+				{Name: "test", AccountID: "123456789012", Region: "us-west-2", ManagerRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole"},
+			}, nil)
+			configStore.EXPECT().ListServices("phonetool").Return(nil, nil).AnyTimes()
+			appCFN := mocks.NewMockcfn(ctrl)
+			appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			envCFN := mocks.NewMockstackDescriber(ctrl)
+			tc.setupEnvCFN(envCFN)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				cfn:         appCFN,
+				newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+					require.Equal(t, "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole", env.ManagerRoleARN)
+					return envCFN, nil
+				},
+
+				includeEnvTags: true,
+				envTagColumns:  []string{"cost-center"},
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEnvs, actual.Envs)
+				require.Equal(t, []string{"cost-center"}, d.RenderOptions().EnvTagColumns)
+			}
+		})
+	}
+}
+
+func TestApp_HumanString_EnvTagColumns(t *testing.T) {
+	// GIVEN
+	app := &App{
+		Name: "phonetool",
+		Envs: []*EnvSummary{
+			{
+				Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+				Tags:        map[string]string{"cost-center": "1234"},
+			},
+		},
+	}
+
+	// WHEN
+	actual := app.HumanStringWithOptions(AppRenderOptions{Sections: []AppSection{SectionEnvironments}, EnvTagColumns: []string{"cost-center", "team"}})
+
+	// THEN
+	require.Equal(t, `Environments (1)
+
+  Name              AccountID           Region              Managed             cost-center         team
+  ----              ---------           ------              -------             -----------         ----
+  test              123456789012        us-west-2           ✗                   1234                -
+`, actual)
+}
+
+func TestAppDescriber_Describe_EnvironmentStatus(t *testing.T) {
+	testCases := map[string]struct {
+		inIncludeEnvTags bool
+
+		wantedEnvs []*EnvSummary
+	}{
+		"derives the health of each environment": {
+			wantedEnvs: []*EnvSummary{
+				{Environment: &config.Environment{Name: "test"}, Status: EnvHealthy},
+				{Environment: &config.Environment{Name: "prod"}, Status: EnvUpdating},
+			},
+		},
+		"shares the stack call with the environment tags": {
+			inIncludeEnvTags: true,
+
+			wantedEnvs: []*EnvSummary{
+				{Environment: &config.Environment{Name: "test"}, Status: EnvHealthy, Tags: map[string]string{"team": "payments"}},
+				{Environment: &config.Environment{Name: "prod"}, Status: EnvUpdating, Tags: map[string]string{"team": "payments"}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+				{Name: "test"},
+				{Name: "prod"},
+			}, nil)
+			configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+			appCFN := mocks.NewMockcfn(ctrl)
+			appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			envCFN := mocks.NewMockstackDescriber(ctrl)
+			tags := []*awscfn.Tag{{Key: aws.String("team"), Value: aws.String("payments")}}
+			envCFN.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{StackStatus: aws.String("UPDATE_COMPLETE"), Tags: tags}, nil).Times(1)
+			envCFN.EXPECT().Describe("phonetool-prod").Return(&cloudformation.StackDescription{StackStatus: aws.String("UPDATE_IN_PROGRESS"), Tags: tags}, nil).Times(1)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				cfn:         appCFN,
+				newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+					return envCFN, nil
+				},
+
+				includeEnvStatus: true,
+				includeEnvTags:   tc.inIncludeEnvTags,
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnvs, actual.Envs)
+		})
+	}
+}
+
+func TestAppDescriber_Describe_EnvironmentRoles(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	configStore := mocks.NewMockAppConfigStore(ctrl)
+	configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+	configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+		{Name: "test"},
+		{Name: "prod"},
+	}, nil)
+	configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+	appCFN := mocks.NewMockcfn(ctrl)
+	appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+	envCFN := mocks.NewMockstackDescriber(ctrl)
+	envCFN.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+		Outputs: []*awscfn.Output{
+			{OutputKey: aws.String("EnvironmentManagerRoleARN"), OutputValue: aws.String("arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole")},
+			{OutputKey: aws.String("CFNExecutionRoleARN"), OutputValue: aws.String("arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole")},
+			{OutputKey: aws.String("VpcId"), OutputValue: aws.String("vpc-1234")},
+		},
+	}, nil)
+	envCFN.EXPECT().Describe("phonetool-prod").Return(&cloudformation.StackDescription{}, nil)
+	d := &AppDescriber{
+		app:         "phonetool",
+		configStore: configStore,
+		cfn:         appCFN,
+		newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+			return envCFN, nil
+		},
+
+		includeEnvRoles: true,
+	}
+
+	// WHEN
+	actual, err := d.Describe()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []*EnvSummary{
+		{Environment: &config.Environment{
+			Name:             "test",
+			ManagerRoleARN:   "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+			ExecutionRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+		}},
+		{Environment: &config.Environment{Name: "prod"}},
+	}, actual.Envs)
+}
+
+func TestAppDescriber_Describe_EnvironmentEndpoints(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	configStore := mocks.NewMockAppConfigStore(ctrl)
+	configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+	configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+		{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+		{Name: "prod", AccountID: "123456789012", Region: "us-west-2"},
+		{Name: "canary", AccountID: "123456789012", Region: "us-west-2"},
+	}, nil)
+	configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+	appCFN := mocks.NewMockcfn(ctrl)
+	appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+	envCFN := mocks.NewMockstackDescriber(ctrl)
+	envCFN.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+		Outputs: []*awscfn.Output{
+			{OutputKey: aws.String("PublicLoadBalancerDNSName"), OutputValue: aws.String("phonetool-test-lb.us-west-2.elb.amazonaws.com")},
+		},
+	}, nil)
+	envCFN.EXPECT().Describe("phonetool-prod").Return(&cloudformation.StackDescription{
+		Outputs: []*awscfn.Output{
+			{OutputKey: aws.String("PublicLoadBalancerDNSName"), OutputValue: aws.String("phonetool-prod-lb.us-west-2.elb.amazonaws.com")},
+			{OutputKey: aws.String("EnvironmentSubdomain"), OutputValue: aws.String("prod.phonetool.example.com")},
+		},
+	}, nil)
+	envCFN.EXPECT().Describe("phonetool-canary").Return(&cloudformation.StackDescription{}, nil)
+	d := &AppDescriber{
+		app:         "phonetool",
+		configStore: configStore,
+		cfn:         appCFN,
+		newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+			return envCFN, nil
+		},
+
+		includeEnvEndpoints: true,
+	}
+
+	// WHEN
+	actual, err := d.Describe()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `Environments (3)
+
+  Name              AccountID           Region              Managed             Endpoint
+  ----              ---------           ------              -------             --------
+  canary            123456789012        us-west-2           ✗                   -
+  prod              123456789012        us-west-2           ✗                   prod.phonetool.example.com
+  test              123456789012        us-west-2           ✗                   phonetool-test-lb.us-west-2.elb.amazonaws.com
+
+  Regions: us-west-2 (3)
+`, actual.HumanStringSections(SectionEnvironments))
+}
+
+func TestApp_HumanString_EnvRoles(t *testing.T) {
+	// GIVEN
+	app := &App{
+		Name: "phonetool",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{
+				Name:             "test",
+				AccountID:        "123456789012",
+				Region:           "us-west-2",
+				ManagerRoleARN:   "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+				ExecutionRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+			}},
+		},
+	}
+
+	// WHEN
+	actual := app.HumanStringSections(SectionEnvironments)
+
+	// THEN
+	require.Equal(t, `Environments (1)
+
+  Name              AccountID           Region              Managed
+  ----              ---------           ------              -------
+  test              123456789012        us-west-2           ✗
+    - Manager Role: arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole
+    - Execution Role: arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole
+`, actual)
+}
+
+func TestEnvHealth(t *testing.T) {
+	testCases := map[string]struct {
+		inStackStatus string
+
+		wanted string
+	}{
+		"created":         {inStackStatus: "CREATE_COMPLETE", wanted: EnvHealthy},
+		"updated":         {inStackStatus: "UPDATE_COMPLETE", wanted: EnvHealthy},
+		"updating":        {inStackStatus: "UPDATE_IN_PROGRESS", wanted: EnvUpdating},
+		"cleaning up":     {inStackStatus: "UPDATE_COMPLETE_CLEANUP_IN_PROGRESS", wanted: EnvUpdating},
+		"rolling back":    {inStackStatus: "UPDATE_ROLLBACK_IN_PROGRESS", wanted: EnvUpdating},
+		"failed":          {inStackStatus: "UPDATE_FAILED", wanted: EnvDegraded},
+		"rolled back":     {inStackStatus: "UPDATE_ROLLBACK_COMPLETE", wanted: EnvDegraded},
+		"rollback failed": {inStackStatus: "UPDATE_ROLLBACK_FAILED", wanted: EnvDegraded},
+		"unknown":         {inStackStatus: "", wanted: EnvUnknown},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, envHealth(tc.inStackStatus))
+		})
+	}
+}
+
+func TestApp_HumanString_EnvStatus(t *testing.T) {
+	// GIVEN
+	app := &App{
+		Name: "phonetool",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}, Status: EnvHealthy},
+		},
+	}
+
+	// WHEN
+	actual := app.HumanStringSections(SectionEnvironments)
+
+	// THEN
+	require.Equal(t, `Environments (1)
+
+  Name              AccountID           Region              Managed             Status
+  ----              ---------           ------              -------             ------
+  test              123456789012        us-west-2           ✗                   healthy
+`, actual)
+}
+
+func TestAppDescriber_Describe_EnvironmentsSortedByAge(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	oldest := time.Date(2020, time.January, 1, 12, 0, 0, 0, time.UTC)
+	newest := time.Date(2021, time.January, 1, 12, 0, 0, 0, time.UTC)
+	configStore := mocks.NewMockAppConfigStore(ctrl)
+	configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+	configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+		{Name: "test"},
+		{Name: "prod"},
+	}, nil)
+	configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+	appCFN := mocks.NewMockcfn(ctrl)
+	appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+	envCFN := mocks.NewMockstackDescriber(ctrl)
+	envCFN.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{CreationTime: &newest}, nil)
+	envCFN.EXPECT().Describe("phonetool-prod").Return(&cloudformation.StackDescription{CreationTime: &oldest}, nil)
+	d := &AppDescriber{
+		app:         "phonetool",
+		configStore: configStore,
+		cfn:         appCFN,
+		newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+			return envCFN, nil
+		},
+
+		sortEnvsByAge: true,
+	}
+
+	// WHEN
+	actual, err := d.Describe()
+
+	// THEN
+	require.NoError(t, err)
+	require.True(t, d.RenderOptions().SortEnvsByAge)
+	require.Equal(t, []*EnvSummary{
+		{Environment: &config.Environment{Name: "test"}, CreationTime: &newest},
+		{Environment: &config.Environment{Name: "prod"}, CreationTime: &oldest},
+	}, actual.Envs)
+}
+
+func TestApp_HumanString_SortEnvsByAge(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2021-04-04T12:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	oldest := time.Date(2020, time.January, 1, 12, 0, 0, 0, time.UTC)
+	newest := time.Date(2021, time.April, 1, 12, 0, 0, 0, time.UTC)
+	app := &App{
+		Name: "phonetool",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "canary", AccountID: "123456789012", Region: "us-west-2"}},
+			{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}, CreationTime: &newest},
+			{Environment: &config.Environment{Name: "prod", AccountID: "123456789012", Region: "us-west-2"}, CreationTime: &oldest},
+		},
+	}
+	testCases := map[string]struct {
+		inSortByAge bool
+
+		wanted string
+	}{
+		"sorts the environments by name by default": {
+			wanted: `Environments (3)
+
+  Name              AccountID           Region              Managed             Created
+  ----              ---------           ------              -------             -------
+  canary            123456789012        us-west-2           ✗                   -
+  prod              123456789012        us-west-2           ✗                   1 year ago
+  test              123456789012        us-west-2           ✗                   3 days ago
+
+  Regions: us-west-2 (3)
+`,
+		},
+		"sorts the environments from the oldest to the newest": {
+			inSortByAge: true,
+
+			wanted: `Environments (3)
+
+  Name              AccountID           Region              Managed             Created
+  ----              ---------           ------              -------             -------
+  prod              123456789012        us-west-2           ✗                   1 year ago
+  test              123456789012        us-west-2           ✗                   3 days ago
+  canary            123456789012        us-west-2           ✗                   -
+
+  Regions: us-west-2 (3)
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, app.HumanStringWithOptions(AppRenderOptions{Sections: []AppSection{SectionEnvironments}, SortEnvsByAge: tc.inSortByAge}))
+		})
+	}
+}
+
+func TestAppDescriber_Describe_Enrichers(t *testing.T) {
+	testError := errors.New("some error")
+	setCompliance := func(app *App) error {
+		app.Extra = map[string]interface{}{"compliance": "passed"}
+		return nil
+	}
+	countEnvs := func(app *App) error {
+		app.Extra["envCount"] = len(app.Envs)
+		return nil
+	}
+	testCases := map[string]struct {
+		inEnrichers []func(*App) error
+
+		wantedExtra map[string]interface{}
+		wantedJSON  string
+		wantedError error
+	}{
+		"runs the enrichers in order": {
+			inEnrichers: []func(*App) error{setCompliance, countEnvs},
+
+			wantedExtra: map[string]interface{}{"compliance": "passed", "envCount": 1},
+			wantedJSON:  `"extra":{"compliance":"passed","envCount":1}`,
+		},
+		"returns the error of the first enricher that fails": {
+			inEnrichers: []func(*App) error{
+				setCompliance,
+				func(app *App) error { return testError },
+				func(app *App) error {
+					require.FailNow(t, "enricher should not run after a failure")
+					return nil
+				},
+			},
+
+			wantedError: fmt.Errorf("run enricher 2 on application phonetool: %w", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			configStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+			configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+			appCFN := mocks.NewMockcfn(ctrl)
+			appCFN.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).AnyTimes()
+			d := NewAppDescriberFromStore("phonetool", configStore, appCFN, WithEnrichers(tc.inEnrichers...))
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedExtra, actual.Extra)
+			data, err := actual.JSONString()
+			require.NoError(t, err)
+			require.Contains(t, data, tc.wantedJSON)
+		})
+	}
+}
+
+func TestAppDescriber_PipelinesOnly(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		includePipelineStages bool
+		setupMocks            func(m *mocks.MockpipelinesGetter)
+
+		wantedApp   *App
+		wantedError error
+	}{
+		"returns error if fail to list pipelines": {
+			setupMocks: func(m *mocks.MockpipelinesGetter) {
+				m.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list pipelines in application phonetool: %w", testError),
+		},
+		"success": {
+			setupMocks: func(m *mocks.MockpipelinesGetter) {
+				m.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline-phonetool"},
+				}, nil)
+				m.EXPECT().LatestExecutionStatus("pipeline-phonetool").Return("InProgress", nil)
+			},
+
+			wantedApp: &App{
+				Name: "phonetool",
+				Pipelines: []*PipelineSummary{
+					{
+						Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool"},
+						Status:   "InProgress",
+					},
+				},
+			},
+		},
+		"leaves out the actions of the stages by default": {
+			setupMocks: func(m *mocks.MockpipelinesGetter) {
+				m.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline-phonetool", Stages: []*codepipeline.Stage{
+						{Name: "Source", Actions: []*codepipeline.Action{{Name: "SourceCodeFor-phonetool"}}},
+					}},
+				}, nil)
+				m.EXPECT().LatestExecutionStatus("pipeline-phonetool").Return("Succeeded", nil)
+			},
+
+			wantedApp: &App{
+				Name: "phonetool",
+				Pipelines: []*PipelineSummary{
+					{
+						Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool", Stages: []*codepipeline.Stage{
+							{Name: "Source", Actions: []*codepipeline.Action{{Name: "SourceCodeFor-phonetool"}}},
+						}},
+						Status: "Succeeded",
+					},
+				},
+			},
+		},
+		"keeps the actions of the stages with WithPipelineStages": {
+			includePipelineStages: true,
+			setupMocks: func(m *mocks.MockpipelinesGetter) {
+				m.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "phonetool"}).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline-phonetool", Stages: []*codepipeline.Stage{
+						{Name: "Source", Actions: []*codepipeline.Action{{Name: "SourceCodeFor-phonetool"}}},
+					}},
+				}, nil)
+				m.EXPECT().LatestExecutionStatus("pipeline-phonetool").Return("Succeeded", nil)
+			},
+
+			wantedApp: &App{
+				Name: "phonetool",
+				Pipelines: []*PipelineSummary{
+					{
+						Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool", Stages: []*codepipeline.Stage{
+							{Name: "Source", Actions: []*codepipeline.Action{{Name: "SourceCodeFor-phonetool"}}},
+						}},
+						Status: "Succeeded",
+						StageActions: []*PipelineStage{
+							{Name: "Source", Actions: []*PipelineAction{{Name: "SourceCodeFor-phonetool"}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockpipelinesGetter(ctrl)
+			tc.setupMocks(m)
+			// The config store and CloudFormation mocks have no expectations as they must not be called.
+			d := &AppDescriber{
+				app:                   "phonetool",
+				configStore:           mocks.NewMockAppConfigStore(ctrl),
+				pipelineSvc:           m,
+				cfn:                   mocks.NewMockcfn(ctrl),
+				includePipelineStages: tc.includePipelineStages,
+			}
+
+			// WHEN
+			actual, err := d.PipelinesOnly()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, actual)
+			}
+		})
+	}
+}
+
+func TestAppDescriber_DescribeEnv(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		inEnv      string
+		setupMocks func(configStore *mocks.MockAppConfigStore, stackSetSvc *mocks.MockstackSetDescriber, envCFN *mocks.MockstackDescriber)
+
+		wanted      *EnvSummary
+		wantedError error
+	}{
+		"returns error if the environment is not part of the application": {
+			inEnv: "staging",
+			setupMocks: func(configStore *mocks.MockAppConfigStore, stackSetSvc *mocks.MockstackSetDescriber, envCFN *mocks.MockstackDescriber) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+			},
+
+			wantedError: errors.New("environment staging is not part of application phonetool"),
+		},
+		"returns error if fail to describe the environment stack": {
+			inEnv: "test",
+			setupMocks: func(configStore *mocks.MockAppConfigStore, stackSetSvc *mocks.MockstackSetDescriber, envCFN *mocks.MockstackDescriber) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				stackSetSvc.EXPECT().InstanceSummaries("phonetool-infrastructure").Return(nil, nil)
+				envCFN.EXPECT().Describe("phonetool-test").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("describe stack phonetool-test of environment test: %w", testError),
+		},
+		"describes only the requested environment": {
+			inEnv: "prod",
+			setupMocks: func(configStore *mocks.MockAppConfigStore, stackSetSvc *mocks.MockstackSetDescriber, envCFN *mocks.MockstackDescriber) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "prod", AccountID: "123456789012", Region: "us-east-1", Prod: true},
+				}, nil)
+				stackSetSvc.EXPECT().InstanceSummaries("phonetool-infrastructure").Return([]stackset.InstanceSummary{
+					{Account: "123456789012", Region: "us-east-1"},
+				}, nil)
+				envCFN.EXPECT().Describe("phonetool-prod").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_COMPLETE"),
+					Outputs: []*awscfn.Output{
+						{OutputKey: aws.String("EnvironmentManagerRoleARN"), OutputValue: aws.String("arn:aws:iam::123456789012:role/phonetool-prod-EnvManagerRole")},
+					},
+				}, nil)
+			},
+
+			wanted: &EnvSummary{
+				Environment: &config.Environment{
+					Name:           "prod",
+					AccountID:      "123456789012",
+					Region:         "us-east-1",
+					Prod:           true,
+					ManagerRoleARN: "arn:aws:iam::123456789012:role/phonetool-prod-EnvManagerRole",
+				},
+				Managed: true,
+				Status:  EnvHealthy,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			stackSetSvc := mocks.NewMockstackSetDescriber(ctrl)
+			envCFN := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMocks(configStore, stackSetSvc, envCFN)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+				stackSetSvc: stackSetSvc,
+				newEnvCFN: func(env *config.Environment) (stackDescriber, error) {
+					return envCFN, nil
+				},
+
+				includeEnvStatus: true,
+				includeEnvRoles:  true,
+			}
+
+			// WHEN
+			actual, err := d.DescribeEnv(tc.inEnv)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
+
+func TestHomeRegionCFNSession(t *testing.T) {
+	testCases := map[string]struct {
+		inRegion string
+		inConfig *aws.Config
+
+		wantedEndpoint string
+		wantedError    error
+	}{
+		"resolves the endpoint of an opt-in region": {
+			inRegion: "me-south-1",
+			inConfig: &aws.Config{Region: aws.String("us-west-2")},
+
+			wantedEndpoint: "https://cloudformation.me-south-1.amazonaws.com",
+		},
+		"resolves the endpoint of a GovCloud region": {
+			inRegion: "us-gov-west-1",
+			inConfig: &aws.Config{},
+
+			wantedEndpoint: "https://cloudformation.us-gov-west-1.amazonaws.com",
+		},
+		"resolves the endpoint of a China region": {
+			inRegion: "cn-north-1",
+			inConfig: &aws.Config{Region: aws.String("cn-north-1")},
+
+			wantedEndpoint: "https://cloudformation.cn-north-1.amazonaws.com.cn",
+		},
+		"returns an error if the region is not part of a known partition": {
+			inRegion: "mars-north-1",
+			inConfig: &aws.Config{Region: aws.String("us-west-2")},
+
+			wantedError: errors.New("resolve partition of region mars-north-1 for application phonetool: region is not part of a known AWS partition"),
+		},
+		"keeps a custom endpoint": {
+			inRegion: "us-east-1",
+			inConfig: &aws.Config{Region: aws.String("us-west-2"), Endpoint: aws.String("http://localhost:4566")},
+
+			wantedEndpoint: "http://localhost:4566",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			sess := &session.Session{Config: tc.inConfig}
+
+			// WHEN
+			actual, err := homeRegionCFNSession("phonetool", tc.inRegion, sess)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEndpoint, aws.StringValue(actual.Config.Endpoint))
+				require.Equal(t, tc.inRegion, aws.StringValue(actual.Config.Region))
+			}
+		})
+	}
+}
+
+func TestNewAppDescriberFromStore(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mocks.NewMockAppConfigStore(ctrl)
+	store.EXPECT().GetApplication("phonetool").Return(&config.Application{
+		Name:   "phonetool",
+		Domain: "https://example.com",
+	}, nil)
+	store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+		{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+	}, nil)
+	store.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+		{Name: "frontend", Type: "Load Balanced Web Service"},
+	}, nil)
+	m := mocks.NewMockcfn(ctrl)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+	m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(`{"TemplateVersion":"v1.0.0"}`, nil)
+	m.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+	d := NewAppDescriberFromStore("phonetool", store, m)
+
+	// WHEN
+	app, describeErr := d.Describe()
+	version, versionErr := d.Version()
+
+	// THEN
+	require.NoError(t, describeErr)
+	require.Equal(t, &App{
+		Name: "phonetool",
+		URI:  "https://example.com",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "us-west-2"}},
+		},
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+		},
+	}, app, "deployments and pipelines are unknown without a deploy store and a pipeline client")
+	require.NoError(t, versionErr)
+	require.Equal(t, "v1.0.0", version)
+}
+
+func TestApp_HumanString_StackTimes(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2021-04-04T12:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	creationTime := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	lastUpdatedTime := time.Date(2021, time.April, 1, 12, 0, 0, 0, time.UTC)
+	app := &App{
+		Name:            "phonetool",
+		URI:             "https://example.com",
+		CreationTime:    &creationTime,
+		LastUpdatedTime: &lastUpdatedTime,
+	}
+
+	// WHEN
+	human := app.HumanStringSections(SectionAbout)
+	data, err := app.JSONString()
+
+	// THEN
+	require.Equal(t, `About
+
+  Name              phonetool
+  URI               https://example.com
+  Created At        1 month ago
+  Updated At        3 days ago
+`, human)
+	require.NoError(t, err)
+	require.Contains(t, data, `"creationTime":"2021-03-01T12:00:00Z","lastUpdatedTime":"2021-04-01T12:00:00Z"`)
+}
+
+func TestAppDescriber_StackNames(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvs []string
+
+		wanted []string
+	}{
+		"returns the app stack and stack set without environments": {
+			wanted: []string{"phonetool-infrastructure-roles", "phonetool-infrastructure"},
+		},
+		"appends the stack of each environment": {
+			inEnvs: []string{"test", "prod"},
+
+			wanted: []string{"phonetool-infrastructure-roles", "phonetool-infrastructure", "phonetool-test", "phonetool-prod"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			d := &AppDescriber{app: "phonetool"}
+
+			// WHEN
+			actual := d.StackNames(tc.inEnvs...)
+
+			// THEN
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
+
+func TestAppDescriber_OrphanedServices(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		withoutDeployStore bool
+		setupMocks         func(configStore *mocks.MockAppConfigStore, deployStore *mocks.MockDeployedServicesLister)
+
+		wanted      []string
+		wantedError error
+	}{
+		"returns the services that aren't deployed in any environment": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, deployStore *mocks.MockDeployedServicesLister) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+					{Name: "worker"}, {Name: "frontend"}, {Name: "api"}, {Name: "batch"},
+				}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend"}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "prod").Return([]string{"api"}, nil)
+			},
+
+			wanted: []string{"batch", "worker"},
+		},
+		"returns every service without environments": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, deployStore *mocks.MockDeployedServicesLister) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{{Name: "frontend"}}, nil)
+			},
+
+			wanted: []string{"frontend"},
+		},
+		"returns error without a deploy store": {
+			withoutDeployStore: true,
+			setupMocks:         func(configStore *mocks.MockAppConfigStore, deployStore *mocks.MockDeployedServicesLister) {},
+
+			wantedError: errors.New("find orphaned services of application phonetool: the deployed services are unknown without a deploy store"),
+		},
+		"returns error if fail to list deployed services": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, deployStore *mocks.MockDeployedServicesLister) {
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{{Name: "frontend"}}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list deployed services in environment test: %w", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			deployStore := mocks.NewMockDeployedServicesLister(ctrl)
+			tc.setupMocks(configStore, deployStore)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+			}
+			if !tc.withoutDeployStore {
+				d.deployStore = deployStore
+			}
+
+			// WHEN
+			actual, err := d.OrphanedServices()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
+
+func TestAppDescriber_EnvsMissingService(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		withoutDeployStore bool
+		setupMocks         func(configStore *mocks.MockAppConfigStore, deployStore *mocks.MockDeployedServicesLister)
+
+		wanted      []string
+		wantedError error
+	}{
+		"returns the environments that the service isn't deployed to": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, deployStore *mocks.MockDeployedServicesLister) {
+				configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{{Name: "frontend"}, {Name: "api"}}, nil)
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}, {Name: "canary"}}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend", "api"}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "prod").Return([]string{"api"}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "canary").Return(nil, nil)
+			},
+
+			wanted: []string{"canary", "prod"},
+		},
+		"returns an empty slice if the service is deployed everywhere": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, deployStore *mocks.MockDeployedServicesLister) {
+				configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{{Name: "frontend"}}, nil)
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend"}, nil)
+			},
+
+			wanted: []string{},
+		},
+		"returns error if the service is unknown": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, deployStore *mocks.MockDeployedServicesLister) {
+				configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{{Name: "api"}}, nil)
+			},
+
+			wantedError: errors.New("service frontend is not part of application phonetool"),
+		},
+		"returns error without a deploy store": {
+			withoutDeployStore: true,
+			setupMocks:         func(configStore *mocks.MockAppConfigStore, deployStore *mocks.MockDeployedServicesLister) {},
+
+			wantedError: errors.New("find environments missing service frontend: the deployed services are unknown without a deploy store"),
+		},
+		"returns error if fail to list deployed services": {
+			setupMocks: func(configStore *mocks.MockAppConfigStore, deployStore *mocks.MockDeployedServicesLister) {
+				configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{{Name: "frontend"}}, nil)
+				configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list deployed services in environment test: %w", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configStore := mocks.NewMockAppConfigStore(ctrl)
+			deployStore := mocks.NewMockDeployedServicesLister(ctrl)
+			tc.setupMocks(configStore, deployStore)
+			d := &AppDescriber{
+				app:         "phonetool",
+				configStore: configStore,
+			}
+			if !tc.withoutDeployStore {
+				d.deployStore = deployStore
+			}
+
+			// WHEN
+			actual, err := d.EnvsMissingService("frontend")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
+
+func TestApp_EnvsInRegion(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test", Region: "eu-west-1"}},
+			{Environment: &config.Environment{Name: "prod", Region: "us-east-1"}},
+			{Environment: &config.Environment{Name: "canary", Region: "eu-west-1"}},
+		},
+	}
+	testCases := map[string]struct {
+		inRegion string
+
+		wanted []*config.Environment
+	}{
+		"returns the environments in the region sorted by name": {
+			inRegion: "eu-west-1",
+
+			wanted: []*config.Environment{
+				{Name: "canary", Region: "eu-west-1"},
+				{Name: "test", Region: "eu-west-1"},
+			},
+		},
+		"returns an empty slice if no environment is in the region": {
+			inRegion: "ap-south-1",
+
+			wanted: []*config.Environment{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual := app.EnvsInRegion(tc.inRegion)
+
+			// THEN
+			require.NotNil(t, actual)
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
+
+func TestApp_ServicesByType(t *testing.T) {
+	testCases := map[string]struct {
+		inServices []*ServiceSummary
+
+		wanted map[string][]*config.Workload
+	}{
+		"returns an empty map without services": {
+			wanted: map[string][]*config.Workload{},
+		},
+		"groups the services by type sorted by name": {
+			inServices: []*ServiceSummary{
+				{Workload: &config.Workload{Name: "worker", Type: "Backend Service"}},
+				{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+				{Workload: &config.Workload{Name: "api", Type: "Backend Service"}},
+				{Workload: &config.Workload{Name: "admin", Type: "Load Balanced Web Service"}},
+			},
+
+			wanted: map[string][]*config.Workload{
+				"Backend Service": {
+					{Name: "api", Type: "Backend Service"},
+					{Name: "worker", Type: "Backend Service"},
+				},
+				"Load Balanced Web Service": {
+					{Name: "admin", Type: "Load Balanced Web Service"},
+					{Name: "frontend", Type: "Load Balanced Web Service"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app := &App{Name: "phonetool", Services: tc.inServices}
+
+			require.Equal(t, tc.wanted, app.ServicesByType())
+		})
+	}
+}
+
+func TestApp_OneLineSummary(t *testing.T) {
+	testCases := map[string]struct {
+		inApp *App
+
+		wanted string
+	}{
+		"without pipelines nor uri": {
+			inApp: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test"}},
+					{Environment: &config.Environment{Name: "prod"}},
+				},
+				Services: []*ServiceSummary{
+					{Workload: &config.Workload{Name: "frontend"}},
+				},
+			},
+
+			wanted: "phonetool: 2 envs, 1 svc, 0 pipelines",
+		},
+		"with pipelines and uri": {
+			inApp: &App{
+				Name: "phonetool",
+				URI:  "example.com",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test"}},
+				},
+				Services: []*ServiceSummary{
+					{Workload: &config.Workload{Name: "frontend"}},
+					{Workload: &config.Workload{Name: "backend"}},
+				},
+				Pipelines: []*PipelineSummary{
+					{Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool"}},
+				},
+			},
+
+			wanted: "phonetool: 1 env, 2 svcs, 1 pipeline (uri: example.com)",
+		},
+		"empty application": {
+			inApp: &App{
+				Name: "phonetool",
+			},
+
+			wanted: "phonetool: 0 envs, 0 svcs, 0 pipelines",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.inApp.OneLineSummary())
+		})
+	}
+}
+
+func TestApp_HumanString_EnvRegion(t *testing.T) {
+	// GIVEN
+	app := &App{
+		Name: "phonetool",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "eu-west-1"}},
+			{Environment: &config.Environment{Name: "prod", AccountID: "123456789012", Region: "us-east-1"}},
+		},
+	}
+
+	// WHEN
+	actual := app.HumanStringWithOptions(AppRenderOptions{Sections: []AppSection{SectionEnvironments}, EnvRegion: "eu-west-1"})
+
+	// THEN
+	require.Equal(t, `Environments (1)
+
+  Name              AccountID           Region              Managed
+  ----              ---------           ------              -------
+  test              123456789012        eu-west-1           ✗
+`, actual)
+	require.Len(t, app.Envs, 2, "expected the app to keep all its environments")
+}
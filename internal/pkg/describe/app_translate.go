@@ -0,0 +1,30 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+// WithHeaderTranslations sets the HeaderTranslations of the RenderOptions of the describer, so that the headers of the human readable format
+// are rendered in another language. translations is keyed by the English text of the headers, such as "Environments" for a section
+// or "Region" for a column, and the headers without a translation stay in English. Values such as names and URIs are never translated.
+func WithHeaderTranslations(translations map[string]string) AppDescriberOption {
+	return func(d *AppDescriber) {
+		d.headerTranslations = translations
+	}
+}
+
+// translate returns the translation of the English header, or the header itself if it has no translation.
+func (a *appView) translate(header string) string {
+	if translated, ok := a.HeaderTranslations[header]; ok && translated != "" {
+		return translated
+	}
+	return header
+}
+
+// translateAll returns the translations of the English headers.
+func (a *appView) translateAll(headers []string) []string {
+	translated := make([]string, len(headers))
+	for i, header := range headers {
+		translated[i] = a.translate(header)
+	}
+	return translated
+}
@@ -0,0 +1,72 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_HumanString_HeaderTranslations(t *testing.T) {
+	app := &App{
+		Name: "phonetool",
+		URI:  "example.com",
+		Envs: []*EnvSummary{
+			{Environment: &config.Environment{Name: "test", AccountID: "123456789012", Region: "eu-west-3"}, Managed: true},
+			{Environment: &config.Environment{Name: "prod", AccountID: "123456789012", Region: "eu-west-3"}},
+		},
+		Services: []*ServiceSummary{
+			{Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"}},
+		},
+		Pipelines: []*PipelineSummary{},
+	}
+	opts := AppRenderOptions{
+		Sections: []AppSection{SectionAbout, SectionEnvironments, SectionServices},
+		HeaderTranslations: map[string]string{
+			"About":        "À propos",
+			"Environments": "Environnements",
+			"Services":     "Services",
+			"Pipelines":    "Pipelines",
+			"Name":         "Nom",
+			"AccountID":    "Compte",
+			"Region":       "Région",
+			"Managed":      "Géré",
+			"Regions":      "Régions",
+		},
+	}
+
+	actual := app.HumanStringWithOptions(opts)
+
+	require.Equal(t, `À propos
+
+  Nom               phonetool
+  URI               alias: example.com
+
+Environnements (2)
+
+  Nom               Compte              Région              Géré
+  ---               ------              ------              ----
+  prod              123456789012        eu-west-3           ✗
+  test              123456789012        eu-west-3           ✓
+
+  Régions: eu-west-3 (2)
+
+Services (1)
+
+  Nom               Type
+  ---               ----
+  frontend          Load Balanced Web Service
+`, actual)
+}
+
+func TestWithHeaderTranslations(t *testing.T) {
+	translations := map[string]string{"Environments": "Umgebungen"}
+	d := NewAppDescriberFromStore("phonetool", nil, nil, WithHeaderTranslations(translations))
+	require.Equal(t, translations, d.RenderOptions().HeaderTranslations)
+	view := (&App{}).view(d.RenderOptions())
+	require.Equal(t, "Umgebungen", view.translate("Environments"))
+	require.Equal(t, "Services", view.translate("Services"))
+}
@@ -0,0 +1,67 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Validate returns an error listing every internal inconsistency of the App struct, such as empty names,
+// duplicate environment names, or deployments and service URLs that reference unknown environments.
+// These can happen when the config store was edited by hand. It returns nil if the description is consistent.
+func (a *App) Validate() error {
+	var problems []string
+	if a.Name == "" {
+		problems = append(problems, "the application name is empty")
+	}
+
+	envs := make(map[string]bool)
+	for i, env := range a.Envs {
+		if env == nil || env.Environment == nil || env.Name == "" {
+			problems = append(problems, fmt.Sprintf("environment #%d has an empty name", i+1))
+			continue
+		}
+		if envs[env.Name] {
+			problems = append(problems, fmt.Sprintf("environment %s is listed more than once", env.Name))
+		}
+		envs[env.Name] = true
+	}
+
+	for i, svc := range a.Services {
+		if svc == nil || svc.Workload == nil || svc.Name == "" {
+			problems = append(problems, fmt.Sprintf("service #%d has an empty name", i+1))
+			continue
+		}
+		var urlEnvs []string
+		for env := range svc.URLs {
+			if !envs[env] {
+				urlEnvs = append(urlEnvs, env)
+			}
+		}
+		sort.Strings(urlEnvs)
+		for _, env := range urlEnvs {
+			problems = append(problems, fmt.Sprintf("service %s has a URL in unknown environment %s", svc.Name, env))
+		}
+	}
+
+	var deployedEnvs []string
+	for env := range a.Deployments {
+		if !envs[env] {
+			deployedEnvs = append(deployedEnvs, env)
+		}
+	}
+	sort.Strings(deployedEnvs)
+	for _, env := range deployedEnvs {
+		problems = append(problems, fmt.Sprintf("services are deployed to unknown environment %s", env))
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return &errInvalidApp{
+		name:     a.Name,
+		problems: problems,
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp *App
+
+		wantedError error
+	}{
+		"returns nil for a consistent description": {
+			inApp: &App{
+				Name: "phonetool",
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test"}},
+				},
+				Services: []*ServiceSummary{
+					{
+						Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"},
+						URLs:     map[string]string{"test": "https://frontend.test.phonetool.com"},
+					},
+				},
+				Deployments: map[string][]string{"test": {"frontend"}},
+			},
+		},
+		"lists every problem": {
+			inApp: &App{
+				Envs: []*EnvSummary{
+					{Environment: &config.Environment{Name: "test"}},
+					{Environment: &config.Environment{Name: "test"}},
+					{Environment: &config.Environment{}},
+				},
+				Services: []*ServiceSummary{
+					{
+						Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"},
+						URLs:     map[string]string{"prod": "https://frontend.prod.phonetool.com"},
+					},
+					{Workload: &config.Workload{Type: "Backend Service"}},
+				},
+				Deployments: map[string][]string{
+					"test":    {"frontend"},
+					"staging": {"frontend"},
+				},
+			},
+
+			wantedError: errors.New("application is inconsistent: the application name is empty; environment test is listed more than once; environment #3 has an empty name; service frontend has a URL in unknown environment prod; service #2 has an empty name; services are deployed to unknown environment staging"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			err := tc.inApp.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"golang.org/x/mod/semver"
+)
+
+// Types of the components of an application that have a template version.
+const (
+	AppComponentStack    = "stack"
+	AppComponentStackSet = "stackset"
+	AppComponentAddons   = "addons"
+)
+
+// NoAddonsVersion is the version returned by AddonsVersion for an application whose services have no addons.
+const NoAddonsVersion = "none"
+
+// AppVersionReport summarizes the template versions of an application's components against a target version.
+// Unlike App, it is meant to be emitted on its own so that CI jobs can gate deployments on UpgradeNeeded.
+type AppVersionReport struct {
+	Application   string                 `json:"application"`
+	TargetVersion string                 `json:"targetVersion"`
+	Components    []*AppComponentVersion `json:"components"`
+	UpgradeNeeded bool                   `json:"upgradeNeeded"` // Set if any component needs to be upgraded.
+}
+
+// AppComponentVersion holds the template version of a single component of an application.
+type AppComponentVersion struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Version       string `json:"version"`
+	IsLegacy      bool   `json:"isLegacy"`
+	UpgradeNeeded bool   `json:"upgradeNeeded"`
+}
+
+// VersionReport returns the template versions of the app CloudFormation stack, stack set and addons stacks compared against target.
+// A component needs an upgrade if its template is legacy or older than target, and the stack set isn't listed if it doesn't exist yet. The addons stacks are listed by name, see AddonsVersion,
+// and never need an upgrade since their templates are owned by the services and not upgraded with the application.
+func (d *AppDescriber) VersionReport(target string) (*AppVersionReport, error) {
+	if !semver.IsValid(target) {
+		return nil, fmt.Errorf("version %s is not a valid semantic version", target)
+	}
+	info, err := d.VersionInfo()
+	if err != nil {
+		return nil, err
+	}
+	report := &AppVersionReport{
+		Application:   d.app,
+		TargetVersion: target,
+		Components: []*AppComponentVersion{
+			d.componentVersion(d.appStackName(), AppComponentStack, info.StackVersion, target),
+		},
+	}
+	if !info.StackSetNotFound {
+		report.Components = append(report.Components, d.componentVersion(d.appStackSetName(), AppComponentStackSet, info.StackSetVersion, target))
+	}
+	addons, err := d.addonsVersions()
+	if err != nil {
+		return nil, err
+	}
+	var addonsStacks []string
+	for name := range addons {
+		addonsStacks = append(addonsStacks, name)
+	}
+	sort.Strings(addonsStacks)
+	for _, name := range addonsStacks {
+		report.Components = append(report.Components, &AppComponentVersion{
+			Name:     name,
+			Type:     AppComponentAddons,
+			Version:  addons[name],
+			IsLegacy: addons[name] == deploy.LegacyAppTemplateVersion,
+		})
+	}
+	for _, component := range report.Components {
+		if component.UpgradeNeeded {
+			report.UpgradeNeeded = true
+		}
+	}
+	return report, nil
+}
+
+func (d *AppDescriber) componentVersion(name, typ, version, target string) *AppComponentVersion {
+	isLegacy := version == deploy.LegacyAppTemplateVersion
+	return &AppComponentVersion{
+		Name:          name,
+		Type:          typ,
+		Version:       version,
+		IsLegacy:      isLegacy,
+		UpgradeNeeded: isLegacy || d.compareVersions(version, target) < 0,
+	}
+}
+
+// JSONString returns the stringified AppVersionReport struct with json format.
+func (r *AppVersionReport) JSONString() (string, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("marshal version report of application %s: %w", r.Application, err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// VersionMatrix returns the template version of each component of the application keyed by the name of its stack or stack set:
+// the app CloudFormation stack, the stack set if it exists, and the addons stack of each deployed service that has addons.
+// Legacy components have the deploy.LegacyAppTemplateVersion version.
+func (d *AppDescriber) VersionMatrix() (map[string]string, error) {
+	info, err := d.VersionInfo()
+	if err != nil {
+		return nil, err
+	}
+	addons, err := d.addonsVersions()
+	if err != nil {
+		return nil, err
+	}
+	matrix := map[string]string{
+		d.appStackName(): info.StackVersion,
+	}
+	if !info.StackSetNotFound {
+		matrix[d.appStackSetName()] = info.StackSetVersion
+	}
+	for name, version := range addons {
+		matrix[name] = version
+	}
+	return matrix, nil
+}
+
+// IsUpToDate returns true if both the app CloudFormation stack and stack set are on target or a newer template version.
+// Legacy components are never up to date. It is meant for scripts to gate on the application's version without parsing output.
+func (d *AppDescriber) IsUpToDate(target string) (bool, error) {
+	if !semver.IsValid(target) {
+		return false, fmt.Errorf("version %s is not a valid semantic version", target)
+	}
+	info, err := d.VersionInfo()
+	if err != nil {
+		return false, err
+	}
+	stack := d.componentVersion(d.appStackName(), AppComponentStack, info.StackVersion, target)
+	if info.StackSetNotFound {
+		return !stack.UpgradeNeeded, nil
+	}
+	stackSet := d.componentVersion(d.appStackSetName(), AppComponentStackSet, info.StackSetVersion, target)
+	return !stack.UpgradeNeeded && !stackSet.UpgradeNeeded, nil
+}
+
+// ExitCode returns 0 if no component of the application needs to be upgraded, and 1 otherwise,
+// so that a command can exit with it for shell scripts to test.
+func (r *AppVersionReport) ExitCode() int {
+	if r.UpgradeNeeded {
+		return 1
+	}
+	return 0
+}
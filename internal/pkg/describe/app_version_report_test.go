@@ -0,0 +1,266 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDescriber_VersionReport(t *testing.T) {
+	testCases := map[string]struct {
+		inTarget             string
+		mockStackMetadata    string
+		mockStackSetMetadata string
+		mockStackSetErr      error
+		withAddons           bool
+
+		wantedJSON string
+		wantedErr  error
+	}{
+		"should return error if the target is not a semantic version": {
+			inTarget: "latest",
+
+			wantedErr: errors.New("version latest is not a valid semantic version"),
+		},
+		"should need an upgrade with legacy templates": {
+			inTarget:             "v1.0.0",
+			mockStackMetadata:    "",
+			mockStackSetMetadata: "",
+
+			wantedJSON: `{"application":"phonetool","targetVersion":"v1.0.0","components":[{"name":"phonetool-infrastructure-roles","type":"stack","version":"v0.0.0","isLegacy":true,"upgradeNeeded":true},{"name":"phonetool-infrastructure","type":"stackset","version":"v0.0.0","isLegacy":true,"upgradeNeeded":true}],"upgradeNeeded":true}` + "\n",
+		},
+		"should need an upgrade if only the stack set is behind": {
+			inTarget:             "v1.0.0",
+			mockStackMetadata:    `{"TemplateVersion":"v1.0.0"}`,
+			mockStackSetMetadata: `{"TemplateVersion":"v0.9.0"}`,
+
+			wantedJSON: `{"application":"phonetool","targetVersion":"v1.0.0","components":[{"name":"phonetool-infrastructure-roles","type":"stack","version":"v1.0.0","isLegacy":false,"upgradeNeeded":false},{"name":"phonetool-infrastructure","type":"stackset","version":"v0.9.0","isLegacy":false,"upgradeNeeded":true}],"upgradeNeeded":true}` + "\n",
+		},
+		"should not need an upgrade if up to date": {
+			inTarget:             "v1.0.0",
+			mockStackMetadata:    `{"TemplateVersion":"v1.0.0"}`,
+			mockStackSetMetadata: `{"TemplateVersion":"v1.0.0"}`,
+
+			wantedJSON: `{"application":"phonetool","targetVersion":"v1.0.0","components":[{"name":"phonetool-infrastructure-roles","type":"stack","version":"v1.0.0","isLegacy":false,"upgradeNeeded":false},{"name":"phonetool-infrastructure","type":"stackset","version":"v1.0.0","isLegacy":false,"upgradeNeeded":false}],"upgradeNeeded":false}` + "\n",
+		},
+		"should list the addons stacks without needing their upgrade": {
+			inTarget:             "v1.2.0",
+			mockStackMetadata:    `{"TemplateVersion":"v1.2.0"}`,
+			mockStackSetMetadata: `{"TemplateVersion":"v1.2.0"}`,
+			withAddons:           true,
+
+			wantedJSON: `{"application":"phonetool","targetVersion":"v1.2.0","components":[{"name":"phonetool-infrastructure-roles","type":"stack","version":"v1.2.0","isLegacy":false,"upgradeNeeded":false},{"name":"phonetool-infrastructure","type":"stackset","version":"v1.2.0","isLegacy":false,"upgradeNeeded":false},{"name":"phonetool-test-frontend-AddonsStack-1ABCDEFGHIJK","type":"addons","version":"v1.1.0","isLegacy":false,"upgradeNeeded":false}],"upgradeNeeded":false}` + "\n",
+		},
+		"should not list a stack set that doesn't exist yet": {
+			inTarget:          "v1.0.0",
+			mockStackMetadata: `{"TemplateVersion":"v1.0.0"}`,
+			mockStackSetErr:   &cloudformation.ErrStackSetNotFound{},
+
+			wantedJSON: `{"application":"phonetool","targetVersion":"v1.0.0","components":[{"name":"phonetool-infrastructure-roles","type":"stack","version":"v1.0.0","isLegacy":false,"upgradeNeeded":false}],"upgradeNeeded":false}` + "\n",
+		},
+		"should not need an upgrade if ahead of the target": {
+			inTarget:             "v1.0.0",
+			mockStackMetadata:    `{"TemplateVersion":"v1.1.0"}`,
+			mockStackSetMetadata: `{"TemplateVersion":"v1.1.0"}`,
+
+			wantedJSON: `{"application":"phonetool","targetVersion":"v1.0.0","components":[{"name":"phonetool-infrastructure-roles","type":"stack","version":"v1.1.0","isLegacy":false,"upgradeNeeded":false},{"name":"phonetool-infrastructure","type":"stackset","version":"v1.1.0","isLegacy":false,"upgradeNeeded":false}],"upgradeNeeded":false}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfn(ctrl)
+			if tc.wantedErr == nil {
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(tc.mockStackMetadata, nil)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(tc.mockStackSetMetadata, tc.mockStackSetErr)
+			}
+			d := &AppDescriber{
+				app: "phonetool",
+				cfn: m,
+			}
+			withFrontendAddons(t, ctrl, d, tc.withAddons)
+
+			// WHEN
+			report, err := d.VersionReport(tc.inTarget)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			actual, err := report.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSON, actual)
+		})
+	}
+}
+
+func TestAppDescriber_VersionMatrix(t *testing.T) {
+	testCases := map[string]struct {
+		mockStackMetadata    string
+		mockStackSetMetadata string
+		mockStackSetErr      error
+		withAddons           bool
+
+		wantedMatrix map[string]string
+		wantedErr    error
+	}{
+		"should return the legacy version of legacy components": {
+			mockStackMetadata:    `{"TemplateVersion":"v1.0.0"}`,
+			mockStackSetMetadata: "",
+
+			wantedMatrix: map[string]string{
+				"phonetool-infrastructure-roles": "v1.0.0",
+				"phonetool-infrastructure":       "v0.0.0",
+			},
+		},
+		"should return the version of each component": {
+			mockStackMetadata:    `{"TemplateVersion":"v1.0.0"}`,
+			mockStackSetMetadata: `{"TemplateVersion":"v0.9.0"}`,
+
+			wantedMatrix: map[string]string{
+				"phonetool-infrastructure-roles": "v1.0.0",
+				"phonetool-infrastructure":       "v0.9.0",
+			},
+		},
+		"should return the version of the addons stacks by stack name": {
+			mockStackMetadata:    `{"TemplateVersion":"v1.0.0"}`,
+			mockStackSetMetadata: `{"TemplateVersion":"v1.0.0"}`,
+			withAddons:           true,
+
+			wantedMatrix: map[string]string{
+				"phonetool-infrastructure-roles":                   "v1.0.0",
+				"phonetool-infrastructure":                         "v1.0.0",
+				"phonetool-test-frontend-AddonsStack-1ABCDEFGHIJK": "v1.1.0",
+			},
+		},
+		"should return error if the stack set metadata can't be retrieved": {
+			mockStackMetadata: `{"TemplateVersion":"v1.0.0"}`,
+			mockStackSetErr:   errors.New("some error"),
+
+			wantedErr: errors.New("get metadata for app stack set phonetool-infrastructure: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfn(ctrl)
+			m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(tc.mockStackMetadata, nil)
+			m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(tc.mockStackSetMetadata, tc.mockStackSetErr)
+			d := &AppDescriber{
+				app: "phonetool",
+				cfn: m,
+			}
+			withFrontendAddons(t, ctrl, d, tc.withAddons)
+
+			// WHEN
+			actual, err := d.VersionMatrix()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedMatrix, actual)
+		})
+	}
+}
+
+func TestAppDescriber_IsUpToDate(t *testing.T) {
+	testCases := map[string]struct {
+		inTarget             string
+		mockStackMetadata    string
+		mockStackSetMetadata string
+		withAddons           bool
+
+		wanted         bool
+		wantedExitCode int
+		wantedErr      error
+	}{
+		"should return error if the target is not a semantic version": {
+			inTarget: "latest",
+
+			wantedErr: errors.New("version latest is not a valid semantic version"),
+		},
+		"should not be up to date with legacy templates": {
+			inTarget:             "v0.0.0",
+			mockStackMetadata:    "",
+			mockStackSetMetadata: "",
+
+			wanted:         false,
+			wantedExitCode: 1,
+		},
+		"should not be up to date if the stack set is behind": {
+			inTarget:             "v1.0.0",
+			mockStackMetadata:    `{"TemplateVersion":"v1.0.0"}`,
+			mockStackSetMetadata: `{"TemplateVersion":"v0.9.0"}`,
+
+			wanted:         false,
+			wantedExitCode: 1,
+		},
+		"should be up to date regardless of the version of the addons": {
+			inTarget:             "v1.2.0",
+			mockStackMetadata:    `{"TemplateVersion":"v1.2.0"}`,
+			mockStackSetMetadata: `{"TemplateVersion":"v1.2.0"}`,
+			withAddons:           true,
+
+			wanted:         true,
+			wantedExitCode: 0,
+		},
+		"should be up to date if both components are on the target or newer": {
+			inTarget:             "v1.0.0",
+			mockStackMetadata:    `{"TemplateVersion":"v1.1.0"}`,
+			mockStackSetMetadata: `{"TemplateVersion":"v1.0.0"}`,
+
+			wanted:         true,
+			wantedExitCode: 0,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfn(ctrl)
+			if tc.wantedErr == nil {
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackName("phonetool-infrastructure-roles")).Return(tc.mockStackMetadata, nil).Times(1)
+				m.EXPECT().MetadataWithContext(gomock.Any(), cloudformation.MetadataWithStackSetName("phonetool-infrastructure")).Return(tc.mockStackSetMetadata, nil).Times(1)
+			}
+			d := &AppDescriber{
+				app: "phonetool",
+				cfn: m,
+			}
+			withFrontendAddons(t, ctrl, d, tc.withAddons)
+
+			// WHEN
+			actual, err := d.IsUpToDate(tc.inTarget)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, actual)
+			report, err := d.VersionReport(tc.inTarget)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedExitCode, report.ExitCode())
+		})
+	}
+}
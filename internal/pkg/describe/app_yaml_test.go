@@ -0,0 +1,60 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_YAMLString_Golden(t *testing.T) {
+	// GIVEN
+	testTime := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	stages := []*codepipeline.Stage{{Name: "Source", Category: "Source", Provider: "GitHub", Details: "Repository: phonetool"}}
+	tags := map[string]string{"team": "payments", "costCenter": "1234", "owner": "phonetool"}
+	app := &App{
+		Name: "phonetool",
+		URI:  "example.com",
+		Envs: []*EnvSummary{ // The environments share the same tags to make sure that no anchor is emitted.
+			{Environment: &config.Environment{Name: "test", Region: "us-west-2", AccountID: "123456789012"}, Managed: true, Tags: tags},
+			{Environment: &config.Environment{Name: "prod", Region: "us-east-1", AccountID: "123456789012", Prod: true}, Tags: tags},
+		},
+		Services: []*ServiceSummary{
+			{
+				Workload: &config.Workload{Name: "frontend", Type: "Load Balanced Web Service"},
+				URLs:     map[string]string{"test": "https://test.example.com", "prod": "https://example.com"},
+			},
+			{Workload: &config.Workload{Name: "api", Type: "Backend Service"}},
+		},
+		Deployments: map[string][]string{"test": {"frontend", "api"}, "prod": {"frontend"}},
+		Pipelines: []*PipelineSummary{
+			{
+				Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool", Region: "us-west-2", AccountID: "123456789012", Stages: stages, CreatedAt: testTime, UpdatedAt: testTime},
+				Status:   "Succeeded",
+			},
+			{
+				Pipeline: &codepipeline.Pipeline{Name: "pipeline-phonetool-api", Region: "us-west-2", AccountID: "123456789012", Stages: stages, CreatedAt: testTime, UpdatedAt: testTime},
+				Status:   "Failed",
+			},
+		},
+		CreationTime: &testTime,
+	}
+	wanted, err := ioutil.ReadFile(filepath.Join("testdata", "app.yaml"))
+	require.NoError(t, err, "unexpected error while reading testdata file")
+
+	// WHEN
+	actual, err := app.YAMLString(AppRenderOptions{})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, string(wanted), actual)
+	require.NotContains(t, actual, "&", "expected no anchors")
+	require.NotContains(t, actual, "*", "expected no aliases")
+}
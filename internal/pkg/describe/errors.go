@@ -0,0 +1,97 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrAppStackNotFound occurs when the CloudFormation stack of an application does not exist,
+// which usually means that the application is not deployed yet.
+var ErrAppStackNotFound = errors.New("app stack not found")
+
+// ErrUnavailableInDryRun occurs when the describer is asked for information that requires AWS API calls
+// while it is in the dry-run mode of WithDryRun.
+var ErrUnavailableInDryRun = errors.New("unavailable in dry-run mode")
+
+// errAppStackNotFound wraps the CloudFormation error returned when the app stack does not exist
+// so that it can be matched against ErrAppStackNotFound.
+type errAppStackNotFound struct {
+	err error
+}
+
+func (e *errAppStackNotFound) Error() string {
+	return e.err.Error()
+}
+
+// Is returns true if target is ErrAppStackNotFound.
+func (e *errAppStackNotFound) Is(target error) bool {
+	return target == ErrAppStackNotFound
+}
+
+// Unwrap returns the underlying CloudFormation error.
+func (e *errAppStackNotFound) Unwrap() error {
+	return e.err
+}
+
+// MetadataError occurs when the Metadata of the template of the app CloudFormation stack or stack set can't be retrieved.
+// It names the stack or stack set that failed so that callers can suggest a targeted remediation.
+type MetadataError struct {
+	StackName  string // Name of the app stack, or of the app stack set if IsStackSet is true.
+	IsStackSet bool
+	Err        error
+}
+
+func (e *MetadataError) Error() string {
+	if e.IsStackSet {
+		return fmt.Sprintf("get metadata for app stack set %s: %v", e.StackName, e.Err)
+	}
+	return fmt.Sprintf("get metadata for app stack %s: %v", e.StackName, e.Err)
+}
+
+// Unwrap returns the underlying CloudFormation error.
+func (e *MetadataError) Unwrap() error {
+	return e.Err
+}
+
+// WarningsError occurs when the description of an application has warnings while the describer is set up with WithFailOnWarnings.
+type WarningsError struct {
+	App      string
+	Warnings []string
+}
+
+func (e *WarningsError) Error() string {
+	return fmt.Sprintf("application %s has %s: %s", e.App, countOf(len(e.Warnings), "warning", "warnings"), strings.Join(e.Warnings, "; "))
+}
+
+// errRegionDisabled occurs when the application's home region rejects the credentials of the session,
+// which happens when the region requires an opt-in that the account didn't enable.
+type errRegionDisabled struct {
+	region string
+	err    error
+}
+
+func (e *errRegionDisabled) Error() string {
+	return fmt.Sprintf("region %s may not be enabled in the account: %v", e.region, e.err)
+}
+
+// Unwrap returns the underlying AWS error.
+func (e *errRegionDisabled) Unwrap() error {
+	return e.err
+}
+
+// errInvalidApp occurs when an application description is not internally consistent.
+type errInvalidApp struct {
+	name     string
+	problems []string
+}
+
+func (e *errInvalidApp) Error() string {
+	if e.name == "" {
+		return fmt.Sprintf("application is inconsistent: %s", strings.Join(e.problems, "; "))
+	}
+	return fmt.Sprintf("application %s is inconsistent: %s", e.name, strings.Join(e.problems, "; "))
+}
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -314,10 +315,12 @@ func (s secrets) humanString(w io.Writer) {
 	}
 }
 
+// underline returns a line of dashes for each heading with as many dashes as the heading has characters,
+// counted in runes rather than bytes so that non-ASCII headings stay aligned.
 func underline(headings []string) []string {
 	var lines []string
 	for _, heading := range headings {
-		line := strings.Repeat("-", len(heading))
+		line := strings.Repeat("-", utf8.RuneCountInString(heading))
 		lines = append(lines, line)
 	}
 	return lines
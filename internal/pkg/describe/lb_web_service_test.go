@@ -656,3 +656,32 @@ Resources
 		})
 	}
 }
+
+func TestUnderline(t *testing.T) {
+	testCases := map[string]struct {
+		inHeadings []string
+
+		wanted []string
+	}{
+		"ascii headings": {
+			inHeadings: []string{"Name", "Region"},
+
+			wanted: []string{"----", "------"},
+		},
+		"multi-byte headings": {
+			inHeadings: []string{"Région", "名前", "Größe"},
+
+			wanted: []string{"------", "--", "-----"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual := underline(tc.inHeadings)
+
+			// THEN
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
@@ -0,0 +1,471 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/app.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	costexplorer "github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	config "github.com/aws/copilot-cli/internal/pkg/config"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAppConfigStore is a mock of AppConfigStore interface.
+type MockAppConfigStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppConfigStoreMockRecorder
+}
+
+// MockAppConfigStoreMockRecorder is the mock recorder for MockAppConfigStore.
+type MockAppConfigStoreMockRecorder struct {
+	mock *MockAppConfigStore
+}
+
+// NewMockAppConfigStore creates a new mock instance.
+func NewMockAppConfigStore(ctrl *gomock.Controller) *MockAppConfigStore {
+	mock := &MockAppConfigStore{ctrl: ctrl}
+	mock.recorder = &MockAppConfigStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAppConfigStore) EXPECT() *MockAppConfigStoreMockRecorder {
+	return m.recorder
+}
+
+// GetApplication mocks base method.
+func (m *MockAppConfigStore) GetApplication(appName string) (*config.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplication", appName)
+	ret0, _ := ret[0].(*config.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplication indicates an expected call of GetApplication.
+func (mr *MockAppConfigStoreMockRecorder) GetApplication(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplication", reflect.TypeOf((*MockAppConfigStore)(nil).GetApplication), appName)
+}
+
+// ListEnvironments mocks base method.
+func (m *MockAppConfigStore) ListEnvironments(appName string) ([]*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments", appName)
+	ret0, _ := ret[0].([]*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockAppConfigStoreMockRecorder) ListEnvironments(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockAppConfigStore)(nil).ListEnvironments), appName)
+}
+
+// ListServices mocks base method.
+func (m *MockAppConfigStore) ListServices(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices.
+func (mr *MockAppConfigStoreMockRecorder) ListServices(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockAppConfigStore)(nil).ListServices), appName)
+}
+
+// MockDeployedServicesLister is a mock of DeployedServicesLister interface.
+type MockDeployedServicesLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeployedServicesListerMockRecorder
+}
+
+// MockDeployedServicesListerMockRecorder is the mock recorder for MockDeployedServicesLister.
+type MockDeployedServicesListerMockRecorder struct {
+	mock *MockDeployedServicesLister
+}
+
+// NewMockDeployedServicesLister creates a new mock instance.
+func NewMockDeployedServicesLister(ctrl *gomock.Controller) *MockDeployedServicesLister {
+	mock := &MockDeployedServicesLister{ctrl: ctrl}
+	mock.recorder = &MockDeployedServicesListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeployedServicesLister) EXPECT() *MockDeployedServicesListerMockRecorder {
+	return m.recorder
+}
+
+// ListDeployedServices mocks base method.
+func (m *MockDeployedServicesLister) ListDeployedServices(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedServices", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedServices indicates an expected call of ListDeployedServices.
+func (mr *MockDeployedServicesListerMockRecorder) ListDeployedServices(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedServices", reflect.TypeOf((*MockDeployedServicesLister)(nil).ListDeployedServices), appName, envName)
+}
+
+// MockstackDescriber is a mock of stackDescriber interface.
+type MockstackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDescriberMockRecorder
+}
+
+// MockstackDescriberMockRecorder is the mock recorder for MockstackDescriber.
+type MockstackDescriberMockRecorder struct {
+	mock *MockstackDescriber
+}
+
+// NewMockstackDescriber creates a new mock instance.
+func NewMockstackDescriber(ctrl *gomock.Controller) *MockstackDescriber {
+	mock := &MockstackDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDescriber) EXPECT() *MockstackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstackDescriber) Describe(stackName string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", stackName)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstackDescriberMockRecorder) Describe(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackDescriber)(nil).Describe), stackName)
+}
+
+// Metadata mocks base method.
+func (m *MockstackDescriber) Metadata(opt cloudformation.MetadataOpts) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Metadata", opt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Metadata indicates an expected call of Metadata.
+func (mr *MockstackDescriberMockRecorder) Metadata(opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metadata", reflect.TypeOf((*MockstackDescriber)(nil).Metadata), opt)
+}
+
+// StackResources mocks base method.
+func (m *MockstackDescriber) StackResources(name string) ([]*cloudformation.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources", name)
+	ret0, _ := ret[0].([]*cloudformation.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockstackDescriberMockRecorder) StackResources(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackDescriber)(nil).StackResources), name)
+}
+
+// TemplateBody mocks base method.
+func (m *MockstackDescriber) TemplateBody(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TemplateBody", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TemplateBody indicates an expected call of TemplateBody.
+func (mr *MockstackDescriberMockRecorder) TemplateBody(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBody", reflect.TypeOf((*MockstackDescriber)(nil).TemplateBody), stackName)
+}
+
+// MockdriftDetector is a mock of driftDetector interface.
+type MockdriftDetector struct {
+	ctrl     *gomock.Controller
+	recorder *MockdriftDetectorMockRecorder
+}
+
+// MockdriftDetectorMockRecorder is the mock recorder for MockdriftDetector.
+type MockdriftDetectorMockRecorder struct {
+	mock *MockdriftDetector
+}
+
+// NewMockdriftDetector creates a new mock instance.
+func NewMockdriftDetector(ctrl *gomock.Controller) *MockdriftDetector {
+	mock := &MockdriftDetector{ctrl: ctrl}
+	mock.recorder = &MockdriftDetectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdriftDetector) EXPECT() *MockdriftDetectorMockRecorder {
+	return m.recorder
+}
+
+// DetectDrift mocks base method.
+func (m *MockdriftDetector) DetectDrift(ctx context.Context, stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", ctx, stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockdriftDetectorMockRecorder) DetectDrift(ctx, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockdriftDetector)(nil).DetectDrift), ctx, stackName)
+}
+
+// MockcostEstimator is a mock of costEstimator interface.
+type MockcostEstimator struct {
+	ctrl     *gomock.Controller
+	recorder *MockcostEstimatorMockRecorder
+}
+
+// MockcostEstimatorMockRecorder is the mock recorder for MockcostEstimator.
+type MockcostEstimatorMockRecorder struct {
+	mock *MockcostEstimator
+}
+
+// NewMockcostEstimator creates a new mock instance.
+func NewMockcostEstimator(ctrl *gomock.Controller) *MockcostEstimator {
+	mock := &MockcostEstimator{ctrl: ctrl}
+	mock.recorder = &MockcostEstimatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcostEstimator) EXPECT() *MockcostEstimatorMockRecorder {
+	return m.recorder
+}
+
+// CostsByTag mocks base method.
+func (m *MockcostEstimator) CostsByTag(tags map[string]string, groupBy string, start, end time.Time) (map[string]costexplorer.Cost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CostsByTag", tags, groupBy, start, end)
+	ret0, _ := ret[0].(map[string]costexplorer.Cost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CostsByTag indicates an expected call of CostsByTag.
+func (mr *MockcostEstimatorMockRecorder) CostsByTag(tags, groupBy, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CostsByTag", reflect.TypeOf((*MockcostEstimator)(nil).CostsByTag), tags, groupBy, start, end)
+}
+
+// MockobjectUploader is a mock of objectUploader interface.
+type MockobjectUploader struct {
+	ctrl     *gomock.Controller
+	recorder *MockobjectUploaderMockRecorder
+}
+
+// MockobjectUploaderMockRecorder is the mock recorder for MockobjectUploader.
+type MockobjectUploaderMockRecorder struct {
+	mock *MockobjectUploader
+}
+
+// NewMockobjectUploader creates a new mock instance.
+func NewMockobjectUploader(ctrl *gomock.Controller) *MockobjectUploader {
+	mock := &MockobjectUploader{ctrl: ctrl}
+	mock.recorder = &MockobjectUploaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockobjectUploader) EXPECT() *MockobjectUploaderMockRecorder {
+	return m.recorder
+}
+
+// PutObject mocks base method.
+func (m *MockobjectUploader) PutObject(bucket, key, contentType string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutObject", bucket, key, contentType, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutObject indicates an expected call of PutObject.
+func (mr *MockobjectUploaderMockRecorder) PutObject(bucket, key, contentType, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockobjectUploader)(nil).PutObject), bucket, key, contentType, data)
+}
+
+// MockwebSvcURIDescriber is a mock of webSvcURIDescriber interface.
+type MockwebSvcURIDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebSvcURIDescriberMockRecorder
+}
+
+// MockwebSvcURIDescriberMockRecorder is the mock recorder for MockwebSvcURIDescriber.
+type MockwebSvcURIDescriberMockRecorder struct {
+	mock *MockwebSvcURIDescriber
+}
+
+// NewMockwebSvcURIDescriber creates a new mock instance.
+func NewMockwebSvcURIDescriber(ctrl *gomock.Controller) *MockwebSvcURIDescriber {
+	mock := &MockwebSvcURIDescriber{ctrl: ctrl}
+	mock.recorder = &MockwebSvcURIDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebSvcURIDescriber) EXPECT() *MockwebSvcURIDescriberMockRecorder {
+	return m.recorder
+}
+
+// URI mocks base method.
+func (m *MockwebSvcURIDescriber) URI(envName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URI", envName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// URI indicates an expected call of URI.
+func (mr *MockwebSvcURIDescriberMockRecorder) URI(envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockwebSvcURIDescriber)(nil).URI), envName)
+}
+
+// MockpipelinesGetter is a mock of pipelinesGetter interface.
+type MockpipelinesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelinesGetterMockRecorder
+}
+
+// MockpipelinesGetterMockRecorder is the mock recorder for MockpipelinesGetter.
+type MockpipelinesGetterMockRecorder struct {
+	mock *MockpipelinesGetter
+}
+
+// NewMockpipelinesGetter creates a new mock instance.
+func NewMockpipelinesGetter(ctrl *gomock.Controller) *MockpipelinesGetter {
+	mock := &MockpipelinesGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelinesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelinesGetter) EXPECT() *MockpipelinesGetterMockRecorder {
+	return m.recorder
+}
+
+// GetPipelinesByTags mocks base method.
+func (m *MockpipelinesGetter) GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelinesByTags", tags)
+	ret0, _ := ret[0].([]*codepipeline.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelinesByTags indicates an expected call of GetPipelinesByTags.
+func (mr *MockpipelinesGetterMockRecorder) GetPipelinesByTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelinesByTags", reflect.TypeOf((*MockpipelinesGetter)(nil).GetPipelinesByTags), tags)
+}
+
+// LatestExecutionStatus mocks base method.
+func (m *MockpipelinesGetter) LatestExecutionStatus(pipelineName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestExecutionStatus", pipelineName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestExecutionStatus indicates an expected call of LatestExecutionStatus.
+func (mr *MockpipelinesGetterMockRecorder) LatestExecutionStatus(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestExecutionStatus", reflect.TypeOf((*MockpipelinesGetter)(nil).LatestExecutionStatus), pipelineName)
+}
+
+// MockstackSetDescriber is a mock of stackSetDescriber interface.
+type MockstackSetDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackSetDescriberMockRecorder
+}
+
+// MockstackSetDescriberMockRecorder is the mock recorder for MockstackSetDescriber.
+type MockstackSetDescriberMockRecorder struct {
+	mock *MockstackSetDescriber
+}
+
+// NewMockstackSetDescriber creates a new mock instance.
+func NewMockstackSetDescriber(ctrl *gomock.Controller) *MockstackSetDescriber {
+	mock := &MockstackSetDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackSetDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackSetDescriber) EXPECT() *MockstackSetDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstackSetDescriber) Describe(name string) (stackset.Description, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(stackset.Description)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstackSetDescriberMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackSetDescriber)(nil).Describe), name)
+}
+
+// InstanceSummaries mocks base method.
+func (m *MockstackSetDescriber) InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InstanceSummaries", varargs...)
+	ret0, _ := ret[0].([]stackset.InstanceSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceSummaries indicates an expected call of InstanceSummaries.
+func (mr *MockstackSetDescriberMockRecorder) InstanceSummaries(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceSummaries", reflect.TypeOf((*MockstackSetDescriber)(nil).InstanceSummaries), varargs...)
+}
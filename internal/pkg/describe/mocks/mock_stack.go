@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -64,6 +65,21 @@ func (mr *MockcfnMockRecorder) Metadata(opt interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metadata", reflect.TypeOf((*Mockcfn)(nil).Metadata), opt)
 }
 
+// MetadataWithContext mocks base method.
+func (m *Mockcfn) MetadataWithContext(ctx context.Context, opt cloudformation.MetadataOpts) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MetadataWithContext", ctx, opt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MetadataWithContext indicates an expected call of MetadataWithContext.
+func (mr *MockcfnMockRecorder) MetadataWithContext(ctx, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetadataWithContext", reflect.TypeOf((*Mockcfn)(nil).MetadataWithContext), ctx, opt)
+}
+
 // StackResources mocks base method.
 func (m *Mockcfn) StackResources(name string) ([]*cloudformation.StackResource, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +94,18 @@ func (mr *MockcfnMockRecorder) StackResources(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*Mockcfn)(nil).StackResources), name)
 }
+
+// TemplateBodyFromChangeSet mocks base method.
+func (m *Mockcfn) TemplateBodyFromChangeSet(changeSetID, stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TemplateBodyFromChangeSet", changeSetID, stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TemplateBodyFromChangeSet indicates an expected call of TemplateBodyFromChangeSet.
+func (mr *MockcfnMockRecorder) TemplateBodyFromChangeSet(changeSetID, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBodyFromChangeSet", reflect.TypeOf((*Mockcfn)(nil).TemplateBodyFromChangeSet), changeSetID, stackName)
+}
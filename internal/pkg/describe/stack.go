@@ -4,6 +4,8 @@
 package describe
 
 import (
+	"context"
+
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 )
 
@@ -11,4 +13,6 @@ type cfn interface {
 	Describe(name string) (*cloudformation.StackDescription, error)
 	StackResources(name string) ([]*cloudformation.StackResource, error)
 	Metadata(opt cloudformation.MetadataOpts) (string, error)
+	MetadataWithContext(ctx context.Context, opt cloudformation.MetadataOpts) (string, error)
+	TemplateBodyFromChangeSet(changeSetID, stackName string) (string, error)
 }